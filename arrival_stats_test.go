@@ -0,0 +1,69 @@
+package lazy_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithArrivalStats_ReportsGaps(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type stats struct{ min, max, mean time.Duration }
+	reported := make(chan stats, 1)
+	clock := newFakeClock()
+	in := make(chan int)
+	src := lazy.New(ctx, in, lazy.WithClock(clock), lazy.WithArrivalStats(func(min, max, mean time.Duration) {
+		reported <- stats{min, max, mean}
+	}))
+
+	out := make(chan int)
+	done := make(chan error, 1)
+	go func() {
+		done <- lazy.Consume(src, func(v int) error {
+			out <- v
+			return nil
+		})
+	}()
+
+	// Values arrive at 0s, 1s, 4s and 6s: gaps of 1s, 3s and 2s.
+	for i, gap := range []time.Duration{time.Second, 3 * time.Second, 2 * time.Second, 0} {
+		in <- i
+		<-out
+		clock.Advance(gap)
+	}
+	close(in)
+	if err := <-done; err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	got := <-reported
+	want := stats{min: time.Second, max: 3 * time.Second, mean: 2 * time.Second}
+	if got != want {
+		t.Fatalf("unexpected stats. got=%+v want=%+v", got, want)
+	}
+}
+
+func TestWithArrivalStats_SingleValueReportsZero(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	var min, max, mean time.Duration
+	src := lazy.NewSlice(ctx, []int{1}, lazy.WithArrivalStats(func(mn, mx, avg time.Duration) {
+		calls++
+		min, max, mean = mn, mx, avg
+	}))
+	if err := lazy.Consume(src, func(int) error { return nil }); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+	if calls != 1 || min != 0 || max != 0 || mean != 0 {
+		t.Fatalf("expected one zero report, got calls=%d min=%v max=%v mean=%v", calls, min, max, mean)
+	}
+}
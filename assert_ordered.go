@@ -0,0 +1,63 @@
+package lazy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrOutOfOrder is reported by AssertOrdered for a value that sorts before
+// its predecessor.
+var ErrOutOfOrder = errors.New("lazy: value out of order")
+
+// AssertOrdered forwards values while checking they are non-decreasing.
+//
+// Input: object[T], less(a, b T) bool
+// Output: object[T] (values are forwarded unchanged)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: a value v with less(v, prev) yields an error wrapping ErrOutOfOrder,
+// handled via WithErrHandler → DecisionStop | DecisionIgnore
+// Buffering: output channel capacity via WithSize
+//
+// prev is the last forwarded value, so with DecisionIgnore a violating value
+// is dropped and later values are checked against the last good one.
+func AssertOrdered[T any](ctx context.Context, obj object[T], less func(a, b T) bool, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		var prev T
+		first := true
+		for v := range obj.ch {
+			if !first && less(v, prev) {
+				err := fmt.Errorf("%w: %v after %v", ErrOutOfOrder, v, prev)
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			opt.handleSuccess()
+			first, prev = false, v
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,50 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestAssertOrdered_StopOnViolation(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 2, 5, 3, 6})
+	checked := lazy.AssertOrdered(ctx, nums, func(a, b int) bool { return a < b },
+		lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var got []int
+	err := lazy.ConsumeE(checked, func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+	if !errors.Is(err, lazy.ErrOutOfOrder) {
+		t.Fatalf("expected ErrOutOfOrder, got %v", err)
+	}
+	want := []int{1, 2, 2, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestAssertOrdered_DefaultIgnoreError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 5, 3, 6})
+	got, err := lazy.Collect(lazy.AssertOrdered(ctx, nums, func(a, b int) bool { return a < b }))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []int{1, 5, 6}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
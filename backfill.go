@@ -0,0 +1,94 @@
+package lazy
+
+import "context"
+
+// Backfill replays a historical stream and then switches to a live one,
+// skipping live values that were already replayed (catch-up-then-subscribe).
+//
+// Input: historical object[T], live func() object[T] (called lazily),
+// dedupKey func(T) any (keys must be comparable)
+// Output: object[T] (all historical values, then live values not seen yet)
+// Order: historical order, then live order
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: ErrBufferExceeded once more replayed keys than
+// WithMaxBufferedItems allows would be retained; stops unless WithErrHandler
+// is given, in which case the value is forwarded but its key is not retained
+// Buffering: output channel capacity via WithSize
+//
+// live is invoked once historical has closed, so the subscription starts
+// right where the replay ended. Each replayed key is forgotten once a live
+// value matches it, so the overlap may arrive in any order and interleaved
+// with new values. When every replayed key has been matched the key set is
+// released and live values pass through untouched; replayed keys the live
+// feed never repeats stay retained.
+func Backfill[T any](ctx context.Context, historical object[T], live func() object[T], dedupKey func(T) any, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := historical.stage + 1
+	errs := newErrCell(historical.errs)
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+
+		seen := make(map[any]struct{})
+		for v := range historical.ch {
+			k := dedupKey(v)
+			if _, tracked := seen[k]; !tracked {
+				if !opt.exceedsBuffer(len(seen)) {
+					seen[k] = struct{}{}
+				} else if decision := opt.handleBufferExceeded(); decision == DecisionStop {
+					errs.set(stage, ErrBufferExceeded)
+					return
+				}
+				// DecisionIgnore: forward the value untracked; live may repeat it
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+
+		feed := live()
+		errs.link(feed.errs)
+		defer feed.Stop()
+		for {
+			var v T
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-feed.ch:
+			}
+			if !ok {
+				return
+			}
+			if seen != nil {
+				k := dedupKey(v)
+				if _, dup := seen[k]; dup {
+					delete(seen, k)
+					if len(seen) == 0 {
+						// Overlap is over; live values are new from here on.
+						seen = nil
+					}
+					continue
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
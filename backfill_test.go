@@ -0,0 +1,118 @@
+package lazy
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/goleak"
+)
+
+// Whitebox: the live factory returns object[T], which package lazy_test
+// cannot name.
+func TestBackfill_SkipsOverlapWithLive(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical := NewSlice(ctx, []int{1, 2, 3, 4})
+	out := Backfill(ctx, historical, func() object[int] {
+		return NewSlice(ctx, []int{3, 4, 5, 6})
+	}, func(v int) any { return v })
+
+	got, err := Collect(out)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestBackfill_LiveStartsAfterHistorical(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	replayed := 0
+	historical := Map(ctx, NewSlice(ctx, []int{1, 2}), func(v int) (int, error) {
+		replayed++
+		return v, nil
+	})
+	out := Backfill(ctx, historical, func() object[int] {
+		if replayed != 2 {
+			t.Errorf("live subscribed after %d historical values", replayed)
+		}
+		return NewSlice(ctx, []int{2, 3})
+	}, func(v int) any { return v })
+
+	got, err := Collect(out)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestBackfill_SkipsOutOfOrderOverlap(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical := NewSlice(ctx, []int{1, 2, 3, 4})
+	out := Backfill(ctx, historical, func() object[int] {
+		// 5 arrives before the replayed 3, which must still be skipped.
+		return NewSlice(ctx, []int{4, 5, 3, 6})
+	}, func(v int) any { return v })
+
+	got, err := Collect(out)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestBackfill_MaxBufferedItemsStops(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical := NewSlice(ctx, []int{1, 2, 3, 4})
+	out := Backfill(ctx, historical, func() object[int] {
+		t.Error("live subscribed after the replay stopped")
+		return NewSlice(ctx, []int{})
+	}, func(v int) any { return v }, WithMaxBufferedItems(2))
+
+	got, err := Collect(out)
+	if !errors.Is(err, ErrBufferExceeded) {
+		t.Fatalf("expected ErrBufferExceeded, got %v", err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestBackfill_MaxBufferedItemsIgnoreForwardsUntracked(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical := NewSlice(ctx, []int{1, 2, 3})
+	out := Backfill(ctx, historical, func() object[int] {
+		return NewSlice(ctx, []int{2, 3, 4})
+	}, func(v int) any { return v }, WithMaxBufferedItems(2),
+		WithErrHandler(func(err error) Decision { return DecisionIgnore }))
+
+	got, err := Collect(out)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	// 3 was replayed untracked, so its live copy comes through again.
+	if want := []int{1, 2, 3, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
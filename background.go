@@ -0,0 +1,29 @@
+package lazy
+
+import "context"
+
+// The BG variants below run on context.Background() for short scripts and
+// examples that never cancel. Their stages can only end by draining: a
+// consumer that stops reading early (a failing Consume, First, TakeWhile, ...)
+// leaves their goroutines blocked for the life of the process. Use the
+// context-taking forms whenever a pipeline may be abandoned.
+
+// NewSliceBG is NewSlice on context.Background().
+func NewSliceBG[T any](slice []T, opts ...optionFunc) object[T] {
+	return NewSlice(context.Background(), slice, opts...)
+}
+
+// NewBG is New on context.Background().
+func NewBG[T any](in <-chan T, opts ...optionFunc) object[T] {
+	return New(context.Background(), in, opts...)
+}
+
+// MapBG is Map on context.Background().
+func MapBG[IN any, OUT any](obj object[IN], mapper func(v IN) (OUT, error), opts ...optionFunc) object[OUT] {
+	return Map(context.Background(), obj, mapper, opts...)
+}
+
+// FilterBG is Filter on context.Background().
+func FilterBG[T any](obj object[T], predicate func(v T) (bool, error), opts ...optionFunc) object[T] {
+	return Filter(context.Background(), obj, predicate, opts...)
+}
@@ -0,0 +1,42 @@
+package lazy_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestBG_ContextlessPipeline(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	nums := lazy.NewSliceBG([]int{1, 2, 3, 4, 5, 6}, lazy.WithSize(5))
+	evens := lazy.FilterBG(nums, func(v int) (bool, error) { return v%2 == 0, nil })
+	squared := lazy.MapBG(evens, func(v int) (int, error) { return v * v, nil }, lazy.WithSize(1))
+
+	got, err := lazy.Collect(squared)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{4, 16, 36}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestNewBG_DrainsChannel(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	in := make(chan string, 2)
+	in <- "a"
+	in <- "b"
+	close(in)
+
+	got, err := lazy.Collect(lazy.NewBG(in))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,54 @@
+package lazy
+
+import "context"
+
+// Batch groups consecutive values into slices of up to size elements.
+//
+// Input: object[T], size (values <= 0 are treated as 1)
+// Output: object[[]T] (full batches, then a final short batch if any remain)
+// Order: preserves input order across and within batches
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// Every emitted slice is a fresh allocation the caller may retain.
+func Batch[T any](ctx context.Context, obj object[T], size int, opts ...optionFunc) object[[]T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan []T, opt.bufferSize(stage))
+	if size <= 0 {
+		size = 1
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		buf := make([]T, 0, size)
+		for v := range obj.ch {
+			buf = append(buf, v)
+			if len(buf) < size {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- buf:
+			}
+			buf = make([]T, 0, size)
+		}
+		if len(buf) > 0 {
+			select {
+			case <-ctx.Done():
+			case ch <- buf:
+			}
+		}
+	}()
+
+	return object[[]T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,42 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestBatch_FinalShortBatch(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	got, err := lazy.Collect(lazy.Batch(ctx, nums, 3))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+
+	want := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {10}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestBatch_NonPositiveSizeIsOne(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.Batch(ctx, lazy.NewSlice(ctx, []int{1, 2}), 0))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := [][]int{{1}, {2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+package lazy
+
+import (
+	"context"
+	"time"
+)
+
+// BatchTimed groups values into slices, flushing when size values are
+// buffered or maxWait has passed since the first buffered value.
+//
+// Input: object[T], size (values <= 0 are treated as 1), maxWait
+// Output: object[[]T] (non-empty batches)
+// Order: preserves input order across and within batches
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// The timer is armed by the first value of each batch on the stage clock (see
+// WithClock) and discarded when the batch flushes. Remaining values are
+// flushed when the input closes. Every emitted slice is a fresh allocation.
+func BatchTimed[T any](ctx context.Context, obj object[T], size int, maxWait time.Duration, opts ...optionFunc) object[[]T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan []T, opt.bufferSize(stage))
+	if size <= 0 {
+		size = 1
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		var buf []T
+		var timer <-chan time.Time
+		flush := func() bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case ch <- buf:
+			}
+			buf, timer = nil, nil
+			return true
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer:
+				if !flush() {
+					return
+				}
+			case v, ok := <-obj.ch:
+				if !ok {
+					if len(buf) > 0 {
+						flush()
+					}
+					return
+				}
+				if len(buf) == 0 {
+					timer = opt.clock.After(maxWait)
+				}
+				buf = append(buf, v)
+				if len(buf) >= size && !flush() {
+					return
+				}
+			}
+		}
+	}()
+
+	return object[[]T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
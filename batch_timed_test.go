@@ -0,0 +1,56 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestBatchTimed_FlushOnSizeOrTimeout(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clock := newFakeClock()
+	in := make(chan int)
+	batches := lazy.BatchTimed(ctx, lazy.New(ctx, in), 3, time.Second, lazy.WithClock(clock))
+
+	out := make(chan []int)
+	go func() {
+		defer close(out)
+		_ = lazy.Consume(batches, func(b []int) error {
+			out <- b
+			return nil
+		})
+	}()
+
+	// A lone slow value is flushed by the timer.
+	in <- 1
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	if got := <-out; !reflect.DeepEqual(got, []int{1}) {
+		t.Fatalf("timed flush: got %v want [1]", got)
+	}
+
+	// A full batch is flushed by size without any time passing.
+	in <- 2
+	in <- 3
+	in <- 4
+	if got := <-out; !reflect.DeepEqual(got, []int{2, 3, 4}) {
+		t.Fatalf("size flush: got %v want [2 3 4]", got)
+	}
+
+	// Leftovers are flushed when the input closes.
+	in <- 5
+	close(in)
+	if got := <-out; !reflect.DeepEqual(got, []int{5}) {
+		t.Fatalf("final flush: got %v want [5]", got)
+	}
+	if _, ok := <-out; ok {
+		t.Fatal("expected no more batches")
+	}
+}
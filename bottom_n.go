@@ -0,0 +1,23 @@
+package lazy
+
+import "slices"
+
+// BottomN drains the object and returns its n smallest values.
+//
+// Input: object[T], n (values < 1 return an empty slice), less(a, b T) bool
+// Output: ([]T, error) up to n values sorted ascending by less
+// Order: ascending; ties keep no particular order
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: retains at most n values in a max-heap
+func BottomN[T any](obj object[T], n int, less func(a, b T) bool) ([]T, error) {
+	greater := func(a, b T) bool { return less(b, a) }
+	h := &boundedHeap[T]{items: []T{}, less: greater}
+	for v := range obj.ch {
+		h.offer(v, n)
+	}
+	out := h.items
+	slices.SortFunc(out, func(a, b T) int { return compareBy(less, a, b) })
+	return out, obj.errs.get()
+}
@@ -0,0 +1,39 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestBottomN_SmallestAscending(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{5, 1, 9, 3})
+	got, err := lazy.BottomN(nums, 2, func(a, b int) bool { return a < b })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestBottomN_NonPositiveIsEmpty(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.BottomN(lazy.NewSlice(ctx, []int{4, 2}), 0, func(a, b int) bool { return a < b })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", got)
+	}
+}
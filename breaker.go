@@ -0,0 +1,44 @@
+package lazy
+
+import "time"
+
+type outcome struct {
+	at     time.Time
+	failed bool
+}
+
+// minRateOutcomes is how many outcomes the window must hold before
+// WithErrorRateBreaker evaluates its ratio, so a single early failure cannot
+// trip it.
+const minRateOutcomes = 5
+
+// rateWindow tracks item outcomes within a sliding time window.
+type rateWindow struct {
+	window    time.Duration
+	threshold float64
+
+	events []outcome
+	failed int
+}
+
+// record adds an outcome at now and reports whether the breaker tripped.
+func (w *rateWindow) record(now time.Time, failed bool) bool {
+	w.events = append(w.events, outcome{at: now, failed: failed})
+	if failed {
+		w.failed++
+	}
+
+	cutoff := now.Add(-w.window)
+	i := 0
+	for ; i < len(w.events) && !w.events[i].at.After(cutoff); i++ {
+		if w.events[i].failed {
+			w.failed--
+		}
+	}
+	w.events = w.events[i:]
+
+	if !failed || len(w.events) < minRateOutcomes {
+		return false
+	}
+	return float64(w.failed)/float64(len(w.events)) > w.threshold
+}
@@ -0,0 +1,77 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithErrorRateBreaker_TripsOnBurst(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clock := newFakeClock()
+	var in []int
+	for i := 1; i <= 20; i++ {
+		in = append(in, i)
+	}
+
+	calls := 0
+	nums := lazy.NewSlice(ctx, in)
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		calls++
+		clock.Advance(time.Second)
+		// A stray error at 3, then a sustained burst from 11 onwards.
+		if v == 3 || v > 10 {
+			return 0, errors.New("unavailable")
+		}
+		return v, nil
+	}, lazy.WithErrorRateBreaker(5*time.Second, 0.5), lazy.WithClock(clock))
+
+	var got []int
+	var stageErr *lazy.StageError
+	if err := lazy.Consume(mapped, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); !errors.As(err, &stageErr) || stageErr.Stage != 1 {
+		t.Fatalf("expected stage 1 error, got %v", err)
+	}
+
+	want := []int{1, 2, 4, 5, 6, 7, 8, 9, 10}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	// Window of 5s at 13s holds items 9..13: 3 errors out of 5 > 0.5.
+	if calls != 13 {
+		t.Fatalf("expected breaker to trip at item 13, mapper ran %d times", calls)
+	}
+}
+
+func TestWithErrorRateBreaker_EarlyErrorDoesNotTrip(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		// A single transient failure on the very first item: 1/1 > 0.5.
+		if v == 1 {
+			return 0, errors.New("flake")
+		}
+		return v, nil
+	}, lazy.WithErrorRateBreaker(time.Minute, 0.5))
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{2, 3, 4, 5, 6, 7, 8, 9, 10}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,91 @@
+package lazy
+
+import "context"
+
+// OverflowPolicy tells Buffer what to do with a value that arrives while its
+// buffer is full.
+type OverflowPolicy int
+
+const (
+	// Block stops reading the input until there is room, like WithSize.
+	Block OverflowPolicy = iota
+	// DropNewest discards the arriving value.
+	DropNewest
+	// DropOldest discards the oldest buffered value to make room.
+	DropOldest
+)
+
+// Buffer decouples a fast producer from a slow consumer through a bounded
+// buffer, dropping values per policy instead of blocking when it is full.
+//
+// Input: object[T], capacity (values < 1 are treated as 1), policy
+// Output: object[T] (the input minus values dropped on overflow)
+// Order: preserves input order for emitted values
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: none
+// Buffering: capacity values in a ring buffer, plus the output channel's
+// capacity via WithSize
+//
+// With Block nothing is dropped and Buffer is equivalent to WithSize(capacity)
+// on the upstream stage. With the drop policies the input is always read
+// promptly, so upstream never waits on the consumer. Buffered values are
+// flushed when the input closes.
+func Buffer[T any](ctx context.Context, obj object[T], capacity int, policy OverflowPolicy, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		ring := make([]T, capacity)
+		head, n := 0, 0
+		in := obj.ch
+		for in != nil || n > 0 {
+			recv := in
+			if n == capacity && policy == Block {
+				recv = nil
+			}
+			var out chan T
+			var next T
+			if n > 0 {
+				out, next = ch, ring[head]
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-recv:
+				if !ok {
+					in = nil
+					continue
+				}
+				switch {
+				case n < capacity:
+					ring[(head+n)%capacity] = v
+					n++
+				case policy == DropOldest:
+					ring[head] = v
+					head = (head + 1) % capacity
+				}
+				// DropNewest: v is discarded
+			case out <- next:
+				var zero T
+				ring[head] = zero
+				head = (head + 1) % capacity
+				n--
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,51 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// burst returns a closed channel pre-filled with 1..n, so a source over it
+// produces everything at once.
+func burst(n int) <-chan int {
+	ch := make(chan int, n)
+	for i := 1; i <= n; i++ {
+		ch <- i
+	}
+	close(ch)
+	return ch
+}
+
+func TestBuffer_DropPolicies(t *testing.T) {
+	tests := []struct {
+		policy lazy.OverflowPolicy
+		want   []int
+	}{
+		{lazy.DropOldest, []int{8, 9, 10}},
+		{lazy.DropNewest, []int{1, 2, 3}},
+		{lazy.Block, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	}
+	for _, tt := range tests {
+		func() {
+			defer goleak.VerifyNone(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			buffered := lazy.Buffer(ctx, lazy.New(ctx, burst(10)), 3, tt.policy)
+			// A slow consumer: let the producer run ahead before reading.
+			time.Sleep(20 * time.Millisecond)
+			got, err := lazy.Collect(buffered)
+			if err != nil {
+				t.Fatalf("collect error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("policy %v: got %v want %v", tt.policy, got, tt.want)
+			}
+		}()
+	}
+}
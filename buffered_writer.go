@@ -0,0 +1,55 @@
+package lazy
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+)
+
+// ToBufferedWriter drains the object through a bufio.Writer over w, flushing
+// every flushEvery items and once at the end.
+//
+// Input: ctx, object[T], w io.Writer, write(*bufio.Writer, T) error,
+// flushEvery (values < 1 flush only at the end)
+// Output: (int, error) number of values accepted by write, first write/flush
+// error
+// Order: writes values in upstream order
+// Cancellation: guards receives with select on ctx.Done(); on cancellation
+// the buffered data is flushed and ctx.Err() is returned
+// Errors: returns the first write or flush error; if the stream closed
+// because a stage hit DecisionStop, that stage's error
+// Buffering: N/A
+//
+// The final flush also runs after a write error, so items written before the
+// failing one reach w. n counts values write accepted into the buffer; after
+// a flush error some of them may not have reached w.
+func ToBufferedWriter[T any](ctx context.Context, obj object[T], w io.Writer, write func(bw *bufio.Writer, v T) error, flushEvery int) (n int, err error) {
+	bw := bufio.NewWriter(w)
+	defer func() {
+		// bufio.Writer errors are sticky, so a failed flush that already
+		// ended the loop would otherwise be reported twice.
+		if ferr := bw.Flush(); ferr != nil && !errors.Is(err, ferr) {
+			err = errors.Join(err, ferr)
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return n, ctx.Err()
+		case v, ok := <-obj.ch:
+			if !ok {
+				return n, obj.errs.get()
+			}
+			if err := write(bw, v); err != nil {
+				return n, err
+			}
+			n++
+			if flushEvery > 0 && n%flushEvery == 0 {
+				if err := bw.Flush(); err != nil {
+					return n, err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package lazy_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// countingWriter counts the writes reaching it; with small items each one
+// is a flush of the bufio.Writer in front of it.
+type countingWriter struct {
+	bytes.Buffer
+	writes int
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.writes++
+	return c.Buffer.Write(p)
+}
+
+func TestToBufferedWriter_FlushCadence(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var in []int
+	for i := range 10 {
+		in = append(in, i)
+	}
+	var w countingWriter
+	n, err := lazy.ToBufferedWriter(ctx, lazy.NewSlice(ctx, in), &w, func(bw *bufio.Writer, v int) error {
+		_, err := fmt.Fprintf(bw, "%d\n", v)
+		return err
+	}, 3)
+	if err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if n != 10 {
+		t.Fatalf("expected 10 items written, got %d", n)
+	}
+	// Flushes after items 3, 6 and 9, then the final one for item 10.
+	if w.writes != 4 {
+		t.Fatalf("expected 4 flushes, got %d", w.writes)
+	}
+	if want := "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n"; w.String() != want {
+		t.Fatalf("unexpected output %q", w.String())
+	}
+}
+
+func TestToBufferedWriter_FlushesBeforeWriteError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	var w strings.Builder
+	n, err := lazy.ToBufferedWriter(ctx, lazy.NewSlice(ctx, []string{"a", "b", "c"}), &w, func(bw *bufio.Writer, v string) error {
+		if v == "c" {
+			return boom
+		}
+		_, err := bw.WriteString(v)
+		return err
+	}, 0)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if n != 2 || w.String() != "ab" {
+		t.Fatalf("got n=%d out=%q, want n=2 out=%q", n, w.String(), "ab")
+	}
+}
+
+// failAfterWriter accepts limit bytes and then fails every write.
+type failAfterWriter struct {
+	limit int
+	err   error
+}
+
+func (f *failAfterWriter) Write(p []byte) (int, error) {
+	if len(p) > f.limit {
+		n := f.limit
+		f.limit = 0
+		return n, f.err
+	}
+	f.limit -= len(p)
+	return len(p), nil
+}
+
+func TestToBufferedWriter_FlushErrorReportedOnce(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	full := errors.New("disk full")
+	w := &failAfterWriter{limit: 2, err: full}
+	_, err := lazy.ToBufferedWriter(ctx, lazy.NewSlice(ctx, []string{"a", "b", "c", "d"}), w, func(bw *bufio.Writer, v string) error {
+		_, err := bw.WriteString(v)
+		return err
+	}, 3)
+	if !errors.Is(err, full) {
+		t.Fatalf("expected %v, got %v", full, err)
+	}
+	if err.Error() != full.Error() {
+		t.Fatalf("expected the flush error once, got %q", err.Error())
+	}
+}
@@ -0,0 +1,127 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+// ChunkMap groups values into chunks, maps whole chunks on a pool of workers
+// and flattens the results.
+//
+// Input: object[IN], chunkSize (values < 1 are treated as 1), workers (values
+// < 1 are treated as 1), mapper([]IN) ([]OUT, error)
+// Output: object[OUT] (elements of each returned slice)
+// Order: NOT preserved across chunks; a chunk's outputs stay together in
+// slice order
+// Cancellation: guards receives, dispatch and sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore; Ignore
+// drops the whole chunk; Stop cancels the remaining workers, so the output
+// closes without waiting for upstream
+// Buffering: output channel capacity via WithSize
+//
+// The last chunk may be short. Each chunk passed to mapper is a fresh slice.
+// The error handler is never called concurrently.
+func ChunkMap[IN any, OUT any](ctx context.Context, obj object[IN], chunkSize, workers int, mapper func(chunk []IN) ([]OUT, error), opts ...optionFunc) object[OUT] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan OUT, opt.bufferSize(stage))
+	if chunkSize < 1 {
+		chunkSize = 1
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	chunks := make(chan []IN)
+
+	// Chunker: cut the input into chunks of chunkSize.
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(chunks)
+		var chunk []IN
+		send := func() bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case chunks <- chunk:
+			}
+			chunk = nil
+			return true
+		}
+		for {
+			var v IN
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-obj.ch:
+			}
+			if !ok {
+				break
+			}
+			chunk = append(chunk, v)
+			if len(chunk) == chunkSize && !send() {
+				return
+			}
+		}
+		if len(chunk) > 0 {
+			send()
+		}
+	}()
+
+	var mu sync.Mutex // serializes opt's error policy state
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer recover()
+			defer wg.Done()
+			for chunk := range chunks {
+				if ctx.Err() != nil {
+					return
+				}
+				results, err := mapper(chunk)
+				if err != nil {
+					mu.Lock()
+					decision := opt.handleError(err)
+					mu.Unlock()
+					if decision == DecisionStop {
+						obj.errs.set(stage, err)
+						cancel()
+						return
+					}
+					// DecisionIgnore: drop chunk and continue
+					continue
+				}
+				mu.Lock()
+				opt.handleSuccess()
+				mu.Unlock()
+
+				for _, result := range results {
+					select {
+					case <-ctx.Done():
+						return
+					case ch <- result:
+					}
+				}
+			}
+		}()
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		wg.Wait()
+		cancel()
+		close(ch)
+	}()
+
+	return object[OUT]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
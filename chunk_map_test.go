@@ -0,0 +1,91 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestChunkMap_AllOutputs(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var in []int
+	for i := range 100 {
+		in = append(in, i)
+	}
+	var chunks atomic.Int32
+	out := lazy.ChunkMap(ctx, lazy.NewSlice(ctx, in), 7, 3, func(chunk []int) ([]int, error) {
+		chunks.Add(1)
+		if len(chunk) > 7 {
+			return nil, errors.New("oversized chunk")
+		}
+		doubled := make([]int, len(chunk))
+		for i, v := range chunk {
+			doubled[i] = v * 2
+		}
+		return doubled, nil
+	})
+
+	got, err := lazy.Collect(out)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != len(in) {
+		t.Fatalf("expected %d outputs, got %d", len(in), len(got))
+	}
+	slices.Sort(got)
+	for i, v := range got {
+		if v != i*2 {
+			t.Fatalf("missing or wrong output at %d: %d", i, v)
+		}
+	}
+	if n := chunks.Load(); n != 15 {
+		t.Fatalf("expected 15 chunks, got %d", n)
+	}
+}
+
+func TestChunkMap_StopOnError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	var in []int
+	for i := range 50 {
+		in = append(in, i)
+	}
+	out := lazy.ChunkMap(ctx, lazy.NewSlice(ctx, in), 5, 3, func(chunk []int) ([]int, error) {
+		return nil, boom
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	err := lazy.ConsumeE(out, func(int) error { return nil })
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+}
+
+func TestChunkMap_StopClosesWithIdleUpstream(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// in is never closed: the chunker sits idle on the receive.
+	in := make(chan int)
+	defer close(in)
+	boom := errors.New("boom")
+	mapped := lazy.ChunkMap(ctx, lazy.New(ctx, in), 1, 3, func(chunk []int) ([]int, error) {
+		return nil, boom
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	in <- 1
+	if _, err := lazy.Collect(mapped); !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+}
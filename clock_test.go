@@ -0,0 +1,139 @@
+package lazy_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// fakeClock is a manually advanced lazy.Clock for deterministic timing tests.
+// Timers and tickers fire only from Advance.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	at     time.Time
+	period time.Duration // > 0 for tickers
+	ch     chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(0, 0)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	return c.add(d, 0).ch
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) lazy.ClockTicker {
+	return &fakeTicker{clock: c, w: c.add(d, d)}
+}
+
+func (c *fakeClock) add(d, period time.Duration) *fakeWaiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &fakeWaiter{at: c.now.Add(d), period: period, ch: make(chan time.Time, 1)}
+	c.waiters = append(c.waiters, w)
+	return w
+}
+
+func (c *fakeClock) remove(w *fakeWaiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, x := range c.waiters {
+		if x == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// Advance moves time forward and fires due timers; like time.Ticker, a slow
+// reader drops ticks rather than queueing them.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	kept := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at.After(c.now) {
+			kept = append(kept, w)
+			continue
+		}
+		select {
+		case w.ch <- c.now:
+		default:
+		}
+		if w.period > 0 {
+			for !w.at.After(c.now) {
+				w.at = w.at.Add(w.period)
+			}
+			kept = append(kept, w)
+		}
+	}
+	c.waiters = kept
+}
+
+// BlockUntil waits until n timers or tickers are pending, so a test can
+// Advance only after the stage under test has armed its timer.
+func (c *fakeClock) BlockUntil(n int) {
+	for {
+		c.mu.Lock()
+		got := len(c.waiters)
+		c.mu.Unlock()
+		if got >= n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+type fakeTicker struct {
+	clock *fakeClock
+	w     *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time { return t.w.ch }
+
+func (t *fakeTicker) Stop() { t.clock.remove(t.w) }
+
+func TestFakeClock_DrivesTickerAndAfter(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	clock := newFakeClock()
+	var c lazy.Clock = clock
+
+	after := c.After(2 * time.Second)
+	ticker := c.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	clock.Advance(time.Second)
+	select {
+	case <-ticker.C():
+	default:
+		t.Fatal("ticker should fire after 1s")
+	}
+	select {
+	case <-after:
+		t.Fatal("After(2s) fired early")
+	default:
+	}
+
+	clock.Advance(time.Second)
+	if got := <-after; !got.Equal(time.Unix(2, 0)) {
+		t.Fatalf("After fired at %v, want %v", got, time.Unix(2, 0))
+	}
+	<-ticker.C()
+}
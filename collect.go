@@ -0,0 +1,18 @@
+package lazy
+
+// Collect drains the object into a slice.
+//
+// Input: object[T]
+// Output: ([]T, error) values in upstream order; non-nil even when empty
+// Order: preserves upstream order
+// Cancellation: N/A; respects upstream closure, returning what was gathered
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: retains every value
+func Collect[T any](obj object[T]) ([]T, error) {
+	out := []T{}
+	for v := range obj.ch {
+		out = append(out, v)
+	}
+	return out, obj.errs.get()
+}
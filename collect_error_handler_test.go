@@ -0,0 +1,42 @@
+package lazy_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestCollectErrorHandler_RecordsAndIgnores(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler, collected := lazy.CollectErrorHandler()
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	odds := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v%2 == 0 {
+			return 0, fmt.Errorf("even: %d", v)
+		}
+		return v, nil
+	}, lazy.WithErrHandler(handler))
+
+	got, err := lazy.Collect(odds)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+
+	errs := collected()
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	if errs[0].Error() != "even: 2" || errs[1].Error() != "even: 4" {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
@@ -0,0 +1,93 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestCollect_Values(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.NewSlice(ctx, []int{3, 1, 2}))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []int{3, 1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestCollect_EmptyInputNonNil(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.NewSlice(ctx, []int{}))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected non-nil empty slice, got %#v", got)
+	}
+}
+
+func TestCollect_PartialOnCancel(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var big []int
+	for i := 0; i < 100000; i++ {
+		big = append(big, i)
+	}
+
+	nums := lazy.NewSlice(ctx, big)
+	id := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 5 {
+			cancel()
+		}
+		return v, nil
+	})
+
+	got, err := lazy.Collect(id)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) < 5 || len(got) == len(big) {
+		t.Fatalf("expected a partial result after cancel, got %d items", len(got))
+	}
+	if !reflect.DeepEqual(got, big[:len(got)]) {
+		t.Fatalf("partial result is not an in-order prefix")
+	}
+}
+
+func TestCollect_ReturnsStageError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 3 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	got, err := lazy.Collect(mapped)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected partial result. got=%v want=%v", got, want)
+	}
+}
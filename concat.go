@@ -0,0 +1,47 @@
+package lazy
+
+import "context"
+
+// Concat chains streams back to back.
+//
+// Input: objs ...object[T]
+// Output: object[T] (all of objs[0], then all of objs[1], ...)
+// Order: argument order, then input order within each stream
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: unbuffered output (objs is variadic, so there are no options)
+//
+// Each input is read only after the previous one has closed. On cancellation
+// Concat returns without draining the remaining inputs, so their producers
+// must be tied to the same ctx. Concatenating zero objects yields an
+// already-closed stream.
+func Concat[T any](ctx context.Context, objs ...object[T]) object[T] {
+	stage := 0
+	errs := newErrCell()
+	for _, obj := range objs {
+		stage = max(stage, obj.stage)
+		errs.link(obj.errs)
+	}
+	stage++
+	ch := make(chan T)
+
+	go func() {
+		defer recover()
+		defer close(ch)
+		for _, obj := range objs {
+			for v := range obj.ch {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- v:
+				}
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
@@ -0,0 +1,41 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestConcat_StrictOrder(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := lazy.NewSlice(ctx, []int{1, 2})
+	b := lazy.NewSlice(ctx, []int{3, 4})
+
+	got, err := lazy.Collect(lazy.Concat(ctx, a, b))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestConcat_NoInputs(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.Concat[int](ctx))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no values, got %v", got)
+	}
+}
@@ -1,12 +1,19 @@
 package lazy
 
+import (
+	"context"
+	"errors"
+)
+
 // Consume drains the object and applies consumer to each value.
 //
 // Input: object[T], consumer func(T) error
-// Output: error (first consumer error, if any)
+// Output: error (first consumer error, else the error that stopped a stage)
 // Order: consumes values in upstream order
 // Cancellation: N/A; respects upstream closure
-// Errors: returns the first error from consumer
+// Errors: returns the first error from consumer; if the stream closed because
+// an upstream stage hit DecisionStop, returns that stage's error as a
+// *StageError carrying its index; nil when the source ended normally
 // Buffering: N/A
 func Consume[IN any](obj object[IN], consumer func(v IN) error) error {
 	for v := range obj.ch {
@@ -14,5 +21,188 @@ func Consume[IN any](obj object[IN], consumer func(v IN) error) error {
 			return err
 		}
 	}
-	return nil
+	return obj.errs.get()
+}
+
+// ConsumeCtx drains the object like Consume but gives up once ctx is done.
+//
+// Input: ctx, object[T], consumer func(T) error
+// Output: error (first consumer error, or ctx.Err() on cancellation)
+// Order: consumes values in upstream order
+// Cancellation: guards receives with select on ctx.Done()
+// Errors: returns the first error from consumer; returns ctx.Err() if ctx is
+// cancelled before the stream closes; if the stream closed because a stage
+// hit DecisionStop, returns that stage's error
+// Buffering: N/A
+//
+// Use it when the stream may never close on its own, e.g. New over a channel
+// nobody closes.
+func ConsumeCtx[IN any](ctx context.Context, obj object[IN], consumer func(v IN) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case v, ok := <-obj.ch:
+			if !ok {
+				return obj.errs.get()
+			}
+			if err := consumer(v); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ConsumeE is Consume; it predates Consume reporting stage errors and is
+// kept for existing callers.
+func ConsumeE[IN any](obj object[IN], consumer func(v IN) error) error {
+	return Consume(obj, consumer)
+}
+
+// ConsumeWithCleanup drains the object like Consume and always runs cleanup.
+//
+// Input: object[T], consumer func(T) error, cleanup func() error
+// Output: error (consumer and cleanup errors joined via errors.Join)
+// Order: consumes values in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: Consume's error (consumer or upstream stage) joined with cleanup's
+// error; nil if neither failed
+// Buffering: N/A
+//
+// cleanup runs exactly once after consumption ends, whether it completed,
+// failed, or panicked.
+func ConsumeWithCleanup[IN any](obj object[IN], consumer func(v IN) error, cleanup func() error) (err error) {
+	defer func() {
+		err = errors.Join(err, cleanup())
+	}()
+	return Consume(obj, consumer)
+}
+
+// ConsumeTracked drains the object like Consume and reports how far it got.
+//
+// Input: object[T], consumer func(T) error
+// Output: (processed int, err error)
+// Order: consumes values in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: returns the first error from consumer; if the stream closed because
+// a stage hit DecisionStop, returns that stage's error
+// Buffering: N/A
+//
+// processed counts the values consumer accepted, so a failing value is not
+// included and a resumed job can skip exactly processed values.
+func ConsumeTracked[IN any](obj object[IN], consumer func(v IN) error) (processed int, err error) {
+	for v := range obj.ch {
+		if err := consumer(v); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, obj.errs.get()
+}
+
+// ConsumeWithCheckpoint drains the object like ConsumeCtx and periodically
+// reports the last consumed value so an interrupted job can resume.
+//
+// Input: ctx, object[T], consumer func(T) error, every (values < 1 only
+// checkpoint on completion), checkpoint func(lastProcessed T) error
+// Output: error (first consumer or checkpoint error, or ctx.Err())
+// Order: consumes values in upstream order
+// Cancellation: guards receives with select on ctx.Done()
+// Errors: returns the first error from consumer or checkpoint; returns
+// ctx.Err() if ctx is cancelled before the stream closes; if the stream closed
+// because a stage hit DecisionStop, returns that stage's error
+// Buffering: N/A
+//
+// checkpoint runs after every every-th successfully consumed value and once
+// more when the stream completes cleanly, unless the last value was just
+// checkpointed or nothing was consumed. A failed, cancelled or upstream-stopped
+// run does not checkpoint on exit, so an aborted run is never recorded as
+// progress past its last periodic checkpoint.
+func ConsumeWithCheckpoint[IN any](ctx context.Context, obj object[IN], consumer func(v IN) error, every int, checkpoint func(lastProcessed IN) error) error {
+	var last IN
+	processed, pending := 0, false
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case v, ok := <-obj.ch:
+			if !ok {
+				if err := obj.errs.get(); err != nil {
+					return err
+				}
+				if pending {
+					return checkpoint(last)
+				}
+				return nil
+			}
+			if err := consumer(v); err != nil {
+				return err
+			}
+			last, pending = v, true
+			processed++
+			if every > 0 && processed%every == 0 {
+				if err := checkpoint(last); err != nil {
+					return err
+				}
+				pending = false
+			}
+		}
+	}
+}
+
+// ConsumeTransactional drains the object in batches, running each through a
+// prepare/commit protocol.
+//
+// Input: ctx, object[T], batchSize (values < 1 are treated as 1),
+// prepare func([]T) error, commit func() error, rollback func() error
+// Output: error (first failed batch, or ctx.Err())
+// Order: batches follow upstream order
+// Cancellation: guards receives with select on ctx.Done()
+// Errors: if prepare or commit fails, rollback runs and consumption stops,
+// returning that error joined with rollback's; ctx.Err() if cancelled first;
+// if the stream closed because a stage hit DecisionStop, that stage's error
+// Buffering: retains up to batchSize values
+//
+// A short final batch is committed when the stream completes cleanly. On
+// cancellation, or when an upstream stage stopped, the values gathered since
+// the last commit are discarded without calling prepare. Each batch passed
+// to prepare is a fresh slice.
+func ConsumeTransactional[IN any](ctx context.Context, obj object[IN], batchSize int, prepare func(batch []IN) error, commit func() error, rollback func() error) error {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	run := func(batch []IN) error {
+		err := prepare(batch)
+		if err == nil {
+			err = commit()
+		}
+		if err != nil {
+			return errors.Join(err, rollback())
+		}
+		return nil
+	}
+	var batch []IN
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case v, ok := <-obj.ch:
+			if !ok {
+				if err := obj.errs.get(); err != nil {
+					return err
+				}
+				if len(batch) > 0 {
+					return run(batch)
+				}
+				return nil
+			}
+			batch = append(batch, v)
+			if len(batch) == batchSize {
+				if err := run(batch); err != nil {
+					return err
+				}
+				batch = nil
+			}
+		}
+	}
 }
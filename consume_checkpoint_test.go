@@ -0,0 +1,101 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestConsumeWithCheckpoint_EveryAndOnCompletion(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var checkpoints []int
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7})
+	err := lazy.ConsumeWithCheckpoint(ctx, nums, func(int) error { return nil }, 3, func(last int) error {
+		checkpoints = append(checkpoints, last)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{3, 6, 7}; !reflect.DeepEqual(checkpoints, want) {
+		t.Fatalf("unexpected checkpoints. got=%v want=%v", checkpoints, want)
+	}
+}
+
+func TestConsumeWithCheckpoint_NoDuplicateFinal(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var checkpoints []int
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6})
+	err := lazy.ConsumeWithCheckpoint(ctx, nums, func(int) error { return nil }, 3, func(last int) error {
+		checkpoints = append(checkpoints, last)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{3, 6}; !reflect.DeepEqual(checkpoints, want) {
+		t.Fatalf("unexpected checkpoints. got=%v want=%v", checkpoints, want)
+	}
+}
+
+func TestConsumeWithCheckpoint_ConsumerErrorSkipsFinal(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	var checkpoints []int
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	err := lazy.ConsumeWithCheckpoint(ctx, nums, func(v int) error {
+		if v == 5 {
+			return boom
+		}
+		return nil
+	}, 2, func(last int) error {
+		checkpoints = append(checkpoints, last)
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if want := []int{2, 4}; !reflect.DeepEqual(checkpoints, want) {
+		t.Fatalf("unexpected checkpoints. got=%v want=%v", checkpoints, want)
+	}
+}
+
+func TestConsumeWithCheckpoint_UpstreamStopSkipsFinal(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 3 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var checkpoints []int
+	err := lazy.ConsumeWithCheckpoint(ctx, mapped, func(int) error { return nil }, 10, func(last int) error {
+		checkpoints = append(checkpoints, last)
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if len(checkpoints) != 0 {
+		t.Fatalf("expected no checkpoint after an aborted run, got %v", checkpoints)
+	}
+}
@@ -0,0 +1,50 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestConsumeWithCleanup_JoinsErrors(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consumeErr := errors.New("consume failed")
+	cleanupErr := errors.New("cleanup failed")
+	err := lazy.ConsumeWithCleanup(lazy.NewSlice(ctx, []int{1, 2, 3}),
+		func(v int) error {
+			if v == 2 {
+				return consumeErr
+			}
+			return nil
+		},
+		func() error { return cleanupErr },
+	)
+
+	if !errors.Is(err, consumeErr) || !errors.Is(err, cleanupErr) {
+		t.Fatalf("expected joined error, got %v", err)
+	}
+}
+
+func TestConsumeWithCleanup_RunsOnSuccess(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cleaned := false
+	err := lazy.ConsumeWithCleanup(lazy.NewSlice(ctx, []int{1, 2}),
+		func(v int) error { return nil },
+		func() error { cleaned = true; return nil },
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cleaned {
+		t.Fatal("cleanup did not run")
+	}
+}
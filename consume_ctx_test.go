@@ -0,0 +1,77 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestConsumeCtx_ReturnsOnCancel(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// New blocks receiving from never until it is closed, so its output stays
+	// open after cancel and only ConsumeCtx's own select can end the loop.
+	never := make(chan int)
+	defer close(never)
+	src := lazy.New(ctx, never)
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+	done := make(chan error, 1)
+	go func() {
+		done <- lazy.ConsumeCtx(ctx, src, func(v int) error { return nil })
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ConsumeCtx did not return after cancel")
+	}
+}
+
+func TestConsumeCtx_DrainsClosedStream(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []int
+	err := lazy.ConsumeCtx(ctx, lazy.NewSlice(ctx, []int{1, 2, 3}), func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestConsumeCtx_ReturnsStageError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 3 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	err := lazy.ConsumeCtx(ctx, mapped, func(int) error { return nil })
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+}
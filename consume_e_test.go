@@ -0,0 +1,56 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestConsumeE_ReturnsStageStopError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 3 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+	evens := lazy.Filter(ctx, mapped, func(v int) (bool, error) { return v%2 == 0, nil })
+
+	count := 0
+	err := lazy.ConsumeE(evens, func(v int) error {
+		count++
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 value before stop, got %d", count)
+	}
+}
+
+func TestConsumeE_NilOnCleanCompletion(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			return 0, errors.New("ignored")
+		}
+		return v, nil
+	})
+
+	if err := lazy.ConsumeE(mapped, func(v int) error { return nil }); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestConsumeTracked_StopsAtFailingItem(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	processed, err := lazy.ConsumeTracked(nums, func(v int) error {
+		if v == 3 {
+			return boom
+		}
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if processed != 2 {
+		t.Fatalf("expected processed=2, got %d", processed)
+	}
+}
+
+func TestConsumeTracked_CountsEverything(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	processed, err := lazy.ConsumeTracked(nums, func(v int) error { return nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if processed != 3 {
+		t.Fatalf("expected processed=3, got %d", processed)
+	}
+}
+
+func TestConsumeTracked_ReturnsStageError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 3 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	processed, err := lazy.ConsumeTracked(mapped, func(int) error { return nil })
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if processed != 2 {
+		t.Fatalf("expected 2 processed, got %d", processed)
+	}
+}
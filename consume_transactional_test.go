@@ -0,0 +1,95 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// txLog records the calls a transactional sink receives.
+type txLog struct {
+	calls    []string
+	prepared [][]int
+}
+
+func (l *txLog) prepare(fail func([]int) bool) func([]int) error {
+	return func(batch []int) error {
+		l.calls = append(l.calls, "prepare")
+		l.prepared = append(l.prepared, batch)
+		if fail != nil && fail(batch) {
+			return errors.New("prepare failed")
+		}
+		return nil
+	}
+}
+
+func (l *txLog) commit() error   { l.calls = append(l.calls, "commit"); return nil }
+func (l *txLog) rollback() error { l.calls = append(l.calls, "rollback"); return nil }
+
+func TestConsumeTransactional_CommitsBatches(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var log txLog
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	if err := lazy.ConsumeTransactional(ctx, nums, 2, log.prepare(nil), log.commit, log.rollback); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := [][]int{{1, 2}, {3, 4}, {5}}; !reflect.DeepEqual(log.prepared, want) {
+		t.Fatalf("unexpected batches %v", log.prepared)
+	}
+	want := []string{"prepare", "commit", "prepare", "commit", "prepare", "commit"}
+	if !reflect.DeepEqual(log.calls, want) {
+		t.Fatalf("unexpected calls %v", log.calls)
+	}
+}
+
+func TestConsumeTransactional_PrepareFailureRollsBack(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var log txLog
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4})
+	err := lazy.ConsumeTransactional(ctx, nums, 2, log.prepare(func(b []int) bool { return b[0] == 3 }), log.commit, log.rollback)
+	if err == nil || err.Error() != "prepare failed" {
+		t.Fatalf("expected prepare error, got %v", err)
+	}
+	want := []string{"prepare", "commit", "prepare", "rollback"}
+	if !reflect.DeepEqual(log.calls, want) {
+		t.Fatalf("unexpected calls %v", log.calls)
+	}
+}
+
+func TestConsumeTransactional_UpstreamStopSkipsPartialBatch(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 4 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var log txLog
+	err := lazy.ConsumeTransactional(ctx, mapped, 2, log.prepare(nil), log.commit, log.rollback)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	// {1, 2} was complete before the stop; the partial {3} is not committed.
+	if want := [][]int{{1, 2}}; !reflect.DeepEqual(log.prepared, want) {
+		t.Fatalf("unexpected batches %v", log.prepared)
+	}
+	if want := []string{"prepare", "commit"}; !reflect.DeepEqual(log.calls, want) {
+		t.Fatalf("unexpected calls %v", log.calls)
+	}
+}
@@ -0,0 +1,87 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+// Controller pauses and resumes every stage it is attached to via
+// WithController. Stages block while paused; no values are dropped.
+// A Controller is safe for concurrent use and starts out running; the zero
+// value is ready to use.
+type Controller struct {
+	mu sync.Mutex
+	// running is closed while the controller is running and replaced by an
+	// open channel on Pause. nil means running until first use; see
+	// runningLocked.
+	running chan struct{}
+}
+
+// NewController returns a running Controller.
+func NewController() *Controller {
+	running := make(chan struct{})
+	close(running)
+	return &Controller{running: running}
+}
+
+// Pause makes attached stages block before their next value. A value already
+// being processed finishes first. Pausing a paused controller is a no-op.
+func (c *Controller) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	select {
+	case <-c.runningLocked():
+		c.running = make(chan struct{})
+	default:
+	}
+}
+
+// Resume releases blocked stages. Resuming a running controller is a no-op.
+func (c *Controller) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	select {
+	case <-c.runningLocked():
+	default:
+		close(c.running)
+	}
+}
+
+// Paused reports whether the controller is paused.
+func (c *Controller) Paused() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	select {
+	case <-c.runningLocked():
+		return false
+	default:
+		return true
+	}
+}
+
+// runningLocked returns c.running, creating it closed for a zero Controller.
+// c.mu must be held.
+func (c *Controller) runningLocked() chan struct{} {
+	if c.running == nil {
+		c.running = make(chan struct{})
+		close(c.running)
+	}
+	return c.running
+}
+
+// wait blocks while c is paused. It returns false if ctx was cancelled
+// first. A nil controller never blocks.
+func (c *Controller) wait(ctx context.Context) bool {
+	if c == nil {
+		return true
+	}
+	c.mu.Lock()
+	running := c.runningLocked()
+	c.mu.Unlock()
+	select {
+	case <-running:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,103 @@
+package lazy_test
+
+import (
+	"context"
+	"iter"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestController_PauseHaltsProgress(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := lazy.NewController()
+	var calls atomic.Int32
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, lazy.WithController(c))
+	out := lazy.Map(ctx, nums, func(v int) (int, error) {
+		calls.Add(1)
+		return v, nil
+	}, lazy.WithController(c))
+
+	next, stop := iter.Pull(lazy.Seq(out))
+	defer stop()
+	for want := 1; want <= 2; want++ {
+		if v, ok := next(); !ok || v != want {
+			t.Fatalf("got (%d, %v), want (%d, true)", v, ok, want)
+		}
+	}
+
+	c.Pause()
+	if !c.Paused() {
+		t.Fatal("expected controller to report paused")
+	}
+	// Map may have taken one more value before noticing the pause.
+	time.Sleep(20 * time.Millisecond)
+	paused := calls.Load()
+	time.Sleep(50 * time.Millisecond)
+	if n := calls.Load(); n != paused || n > 3 {
+		t.Fatalf("mapper progressed while paused: %d calls, then %d", paused, n)
+	}
+
+	c.Resume()
+	for want := 3; want <= 10; want++ {
+		if v, ok := next(); !ok || v != want {
+			t.Fatalf("got (%d, %v), want (%d, true)", v, ok, want)
+		}
+	}
+	if _, ok := next(); ok {
+		t.Fatal("expected stream to end after resume")
+	}
+}
+
+func TestController_CancelWhilePaused(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := lazy.NewController()
+	c.Pause()
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3}, lazy.WithController(c))
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+	got, err := lazy.Collect(nums)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected nothing while paused, got %v", got)
+	}
+}
+
+func TestController_ZeroValue(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var c lazy.Controller
+	if c.Paused() {
+		t.Fatal("expected zero controller to start running")
+	}
+	c.Resume()
+	got, err := lazy.Collect(lazy.NewSlice(ctx, []int{1, 2, 3}, lazy.WithController(&c)))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 values, got %v", got)
+	}
+
+	c.Pause()
+	if !c.Paused() {
+		t.Fatal("expected controller to report paused")
+	}
+	c.Resume()
+	if c.Paused() {
+		t.Fatal("expected controller to report running after resume")
+	}
+}
@@ -0,0 +1,55 @@
+package lazy
+
+import (
+	"math"
+	"math/bits"
+)
+
+// hllPrecision gives 2^14 registers: ~16 KiB of state and a standard error
+// of about 1.04/sqrt(2^14) ≈ 0.81%.
+const hllPrecision = 14
+
+// CountDistinctApprox drains the object and estimates how many distinct
+// values it carried, using HyperLogLog.
+//
+// Input: object[T], hash(T) uint64 (should be well mixed across all 64 bits)
+// Output: (uint64, error) estimated cardinality
+// Order: N/A
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: fixed 16 KiB of registers regardless of stream size
+//
+// The relative standard error is about 0.8%, so roughly 95% of estimates fall
+// within ±1.6% of the true count. Small cardinalities use linear counting and
+// are close to exact. Quality depends on hash: poorly mixed hashes bias the
+// estimate.
+func CountDistinctApprox[T any](obj object[T], hash func(v T) uint64) (uint64, error) {
+	const m = 1 << hllPrecision
+	var registers [m]uint8
+	for v := range obj.ch {
+		h := hash(v)
+		idx := h >> (64 - hllPrecision)
+		// Set a sentinel bit so rank is bounded by 64-p+1.
+		rest := h<<hllPrecision | 1<<(hllPrecision-1)
+		rank := uint8(bits.LeadingZeros64(rest) + 1)
+		if rank > registers[idx] {
+			registers[idx] = rank
+		}
+	}
+
+	sum := 0.0
+	zeros := 0
+	for _, r := range registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/float64(m))
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(float64(m)/float64(zeros))
+	}
+	return uint64(math.Round(estimate)), obj.errs.get()
+}
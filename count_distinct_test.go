@@ -0,0 +1,60 @@
+package lazy_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// mix64 is the splitmix64 finalizer, spreading small ints over 64 bits.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func TestCountDistinctApprox_WithinErrorBound(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const distinct = 100000
+	var in []int
+	for i := 0; i < distinct; i++ {
+		in = append(in, i, i) // every value twice
+	}
+
+	got, err := lazy.CountDistinctApprox(lazy.NewSlice(ctx, in), func(v int) uint64 {
+		return mix64(uint64(v))
+	})
+	if err != nil {
+		t.Fatalf("count error: %v", err)
+	}
+
+	// ~4 standard errors of headroom keeps the test deterministic in practice.
+	if rel := math.Abs(float64(got)-distinct) / distinct; rel > 0.035 {
+		t.Fatalf("estimate %d off by %.2f%% from %d", got, rel*100, distinct)
+	}
+}
+
+func TestCountDistinctApprox_SmallExact(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.CountDistinctApprox(lazy.NewSlice(ctx, []int{1, 2, 3, 2, 1}), func(v int) uint64 {
+		return mix64(uint64(v))
+	})
+	if err != nil {
+		t.Fatalf("count error: %v", err)
+	}
+	if got != 3 {
+		t.Fatalf("expected 3, got %d", got)
+	}
+}
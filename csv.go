@@ -0,0 +1,116 @@
+package lazy
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+)
+
+// FromCSV reads CSV records from r and maps each to a value.
+//
+// Input: r io.Reader, rowMapper(record []string) (T, error)
+// Output: object[T]
+// Order: preserves record order for emitted values
+// Cancellation: checked between records; guards sends with select on ctx.Done()
+// Errors: malformed rows and rowMapper errors handled via WithErrHandler →
+// DecisionStop | DecisionIgnore; a read error from r always stops the stream
+// Buffering: output channel capacity via WithSize
+//
+// With WithHeader the first record is skipped. Rows must all have the field
+// count of the first record; others count as malformed.
+func FromCSV[T any](ctx context.Context, r io.Reader, rowMapper func(record []string) (T, error), opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	ch := make(chan T, opt.bufferSize(0))
+	errs := newErrCell()
+
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		cr := csv.NewReader(r)
+		skipHeader := opt.header
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			record, err := cr.Read()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				var perr *csv.ParseError
+				if !errors.As(err, &perr) {
+					errs.set(0, err)
+					return
+				}
+				if decision := opt.handleError(err); decision == DecisionStop {
+					errs.set(0, err)
+					return
+				}
+				// DecisionIgnore: skip malformed row and continue
+				continue
+			}
+			if skipHeader {
+				skipHeader = false
+				continue
+			}
+
+			v, err := rowMapper(record)
+			if err != nil {
+				if decision := opt.handleError(err); decision == DecisionStop {
+					errs.set(0, err)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			opt.handleSuccess()
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:   ch,
+		errs: errs,
+	}
+}
+
+// ToCSV drains the object into w as CSV records.
+//
+// Input: object[T], w io.Writer, row(T) []string, headers (skipped when empty)
+// Output: (int, error) number of value rows written, first write error
+// Order: writes values in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: returns the first write or flush error; if the stream closed
+// because a stage hit DecisionStop, that stage's error (rows already written
+// are still flushed)
+// Buffering: N/A
+//
+// The csv.Writer is flushed before returning.
+func ToCSV[T any](obj object[T], w io.Writer, row func(v T) []string, headers []string) (int, error) {
+	cw := csv.NewWriter(w)
+	if len(headers) > 0 {
+		if err := cw.Write(headers); err != nil {
+			return 0, err
+		}
+	}
+	n := 0
+	for v := range obj.ch {
+		if err := cw.Write(row(v)); err != nil {
+			return n, err
+		}
+		n++
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return n, err
+	}
+	return n, obj.errs.get()
+}
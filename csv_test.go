@@ -0,0 +1,109 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func parsePerson(record []string) (person, error) {
+	age, err := strconv.Atoi(record[1])
+	if err != nil {
+		return person{}, err
+	}
+	return person{Name: record[0], Age: age}, nil
+}
+
+func TestFromCSV_SkipsHeaderAndBadRows(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	in := strings.NewReader("name,age\n" +
+		"alice,30\n" +
+		"bob,notanumber\n" +
+		"carol,41,extra\n" +
+		"dave,25\n")
+	people := lazy.FromCSV(ctx, in, parsePerson, lazy.WithHeader())
+
+	var got []person
+	if err := lazy.Consume(people, func(p person) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []person{{"alice", 30}, {"dave", 25}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestFromCSV_StopOnError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	in := strings.NewReader("alice,30\nbob,x\ndave,25\n")
+	people := lazy.FromCSV(ctx, in, parsePerson, lazy.WithErrHandler(func(err error) lazy.Decision {
+		return lazy.DecisionStop
+	}))
+
+	var got []person
+	var stageErr *lazy.StageError
+	if err := lazy.Consume(people, func(p person) error {
+		got = append(got, p)
+		return nil
+	}); !errors.As(err, &stageErr) || stageErr.Stage != 0 {
+		t.Fatalf("expected stage 0 error, got %v", err)
+	}
+
+	want := []person{{"alice", 30}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestToCSV_RoundTrip(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	want := []person{{"alice", 30}, {"bob, jr.", 7}}
+
+	var buf strings.Builder
+	n, err := lazy.ToCSV(lazy.NewSlice(ctx, want), &buf, func(p person) []string {
+		return []string{p.Name, strconv.Itoa(p.Age)}
+	}, []string{"name", "age"})
+	if err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if n != len(want) {
+		t.Fatalf("expected %d rows, got %d", len(want), n)
+	}
+
+	people := lazy.FromCSV(ctx, strings.NewReader(buf.String()), parsePerson, lazy.WithHeader())
+	var got []person
+	if err := lazy.Consume(people, func(p person) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package lazy
+
+import (
+	"context"
+	"time"
+)
+
+// DebounceMax emits the latest value once the input has been quiet for
+// quiet, but no later than maxWait after the first value of a burst.
+//
+// Input: object[T], quiet, maxWait
+// Output: object[T] (one value per burst, or per maxWait under continuous
+// input)
+// Order: preserves input order for emitted values
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// Every value restarts the quiet timer; the first value after an emission
+// also starts the maxWait timer, so continuous input still yields a value at
+// least every maxWait. Timers run on the stage clock (see WithClock). A value
+// still pending when the input closes is emitted before the output closes.
+func DebounceMax[T any](ctx context.Context, obj object[T], quiet, maxWait time.Duration, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		var pending T
+		var quietTimer, maxTimer <-chan time.Time
+		emit := func() bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case ch <- pending:
+			}
+			var zero T
+			pending, quietTimer, maxTimer = zero, nil, nil
+			return true
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-quietTimer:
+				if !emit() {
+					return
+				}
+			case <-maxTimer:
+				if !emit() {
+					return
+				}
+			case v, ok := <-obj.ch:
+				if !ok {
+					if quietTimer != nil {
+						emit()
+					}
+					return
+				}
+				pending = v
+				quietTimer = opt.clock.After(quiet)
+				if maxTimer == nil {
+					maxTimer = opt.clock.After(maxWait)
+				}
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
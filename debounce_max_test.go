@@ -0,0 +1,65 @@
+package lazy_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestDebounceMax_EmitsEveryMaxWaitUnderContinuousInput(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clock := newFakeClock()
+	in := make(chan int)
+	debounced := lazy.DebounceMax(ctx, lazy.New(ctx, in), time.Second, 3*time.Second, lazy.WithClock(clock))
+
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		_ = lazy.Consume(debounced, func(v int) error {
+			out <- v
+			return nil
+		})
+	}()
+
+	// A value every 500ms never lets the 1s quiet timer fire, so only the
+	// 3s max-wait timer releases a value. Before each advance the current
+	// quiet and max timers are pending (plus nothing else: the previous quiet
+	// timer fired on the last advance).
+	for v := 1; v <= 6; v++ {
+		in <- v
+		clock.BlockUntil(min(v+1, 3))
+		select {
+		case got := <-out:
+			t.Fatalf("value %d emitted before max wait", got)
+		default:
+		}
+		clock.Advance(500 * time.Millisecond)
+	}
+	if got := <-out; got != 6 {
+		t.Fatalf("max-wait emission: got %d want 6", got)
+	}
+
+	// A lone value is released by the quiet timer.
+	in <- 7
+	clock.BlockUntil(3)
+	clock.Advance(time.Second)
+	if got := <-out; got != 7 {
+		t.Fatalf("quiet emission: got %d want 7", got)
+	}
+
+	// A value pending at close is flushed.
+	in <- 8
+	close(in)
+	if got := <-out; got != 8 {
+		t.Fatalf("flush on close: got %d want 8", got)
+	}
+	if _, ok := <-out; ok {
+		t.Fatal("expected stream to end")
+	}
+}
@@ -0,0 +1,76 @@
+package lazy
+
+import (
+	"context"
+	"time"
+)
+
+// DedupPerWindow forwards the first value per key within each tumbling time
+// window and drops the rest.
+//
+// Input: object[T], key(T) K, window
+// Output: object[T] (first occurrence of each key per window)
+// Order: preserves input order for emitted values
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// Windows are aligned to the arrival of the first value and measured on the
+// stage clock (see WithClock). The seen keys are forgotten when a value
+// arrives in a later window, so memory is bounded by the distinct keys of one
+// window. A window <= 0 makes every value its own window.
+func DedupPerWindow[T any, K comparable](ctx context.Context, obj object[T], key func(v T) K, window time.Duration, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		seen := map[K]struct{}{}
+		var start time.Time
+		started := false
+		for {
+			var v T
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-obj.ch:
+			}
+			if !ok {
+				return
+			}
+
+			now := opt.clock.Now()
+			switch {
+			case !started:
+				start, started = now, true
+			case window <= 0:
+				clear(seen)
+			case now.Sub(start) >= window:
+				start = start.Add(now.Sub(start) / window * window)
+				clear(seen)
+			}
+			k := key(v)
+			if _, dup := seen[k]; dup {
+				continue
+			}
+			seen[k] = struct{}{}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
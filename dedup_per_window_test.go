@@ -0,0 +1,61 @@
+package lazy_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestDedupPerWindow_KeyPassesAgainNextWindow(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clock := newFakeClock()
+	in := make(chan string)
+	deduped := lazy.DedupPerWindow(ctx, lazy.New(ctx, in), func(s string) string { return s }, time.Minute, lazy.WithClock(clock))
+
+	out := make(chan string)
+	go func() {
+		defer close(out)
+		_ = lazy.Consume(deduped, func(s string) error {
+			out <- s
+			return nil
+		})
+	}()
+
+	// pass marks values expected out; reading them also proves the drops
+	// before them were processed before the clock moves on.
+	steps := []struct {
+		advance time.Duration
+		send    string
+		pass    bool
+	}{
+		{0, "a", true},
+		{0, "a", false},
+		{0, "b", true},
+		{30 * time.Second, "a", false},
+		{0, "c", true},
+		// 70s: the second window starts at 60s.
+		{40 * time.Second, "a", true},
+		{0, "c", true},
+		{0, "a", false},
+		{0, "d", true},
+	}
+	for i, s := range steps {
+		clock.Advance(s.advance)
+		in <- s.send
+		if s.pass {
+			if got := <-out; got != s.send {
+				t.Fatalf("step %d: got %q want %q", i, got, s.send)
+			}
+		}
+	}
+	close(in)
+	if v, ok := <-out; ok {
+		t.Fatalf("unexpected extra value %q", v)
+	}
+}
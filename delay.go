@@ -0,0 +1,63 @@
+package lazy
+
+import (
+	"context"
+	"time"
+)
+
+// Delay waits d before forwarding each value.
+//
+// Input: object[T], d (values <= 0 forward immediately)
+// Output: object[T] (every input value, delayed)
+// Order: preserves input order for emitted values
+// Cancellation: guards waits, receives and sends with select on ctx.Done(),
+// so cancellation cuts a pending delay short
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// Delays run back to back on the stage clock (see WithClock): n values take
+// at least n*d. Unlike Throttle, the wait is paid per value even when the
+// input is slow.
+func Delay[T any](ctx context.Context, obj object[T], d time.Duration, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for {
+			var v T
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-obj.ch:
+			}
+			if !ok {
+				return
+			}
+			if d > 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case <-opt.clock.After(d):
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
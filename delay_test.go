@@ -0,0 +1,70 @@
+package lazy_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestDelay_WaitsBeforeEachValue(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clock := newFakeClock()
+	delayed := lazy.Delay(ctx, lazy.NewSlice(ctx, []int{1, 2}), time.Second, lazy.WithClock(clock))
+
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		_ = lazy.Consume(delayed, func(v int) error {
+			out <- v
+			return nil
+		})
+	}()
+
+	for _, want := range []int{1, 2} {
+		clock.BlockUntil(1)
+		select {
+		case v := <-out:
+			t.Fatalf("value %d forwarded before its delay", v)
+		default:
+		}
+		clock.Advance(time.Second)
+		if got := <-out; got != want {
+			t.Fatalf("got %d want %d", got, want)
+		}
+	}
+	if _, ok := <-out; ok {
+		t.Fatal("expected stream to end")
+	}
+}
+
+func TestDelay_CancelCutsDelayShort(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 100 values at 50ms each would take 5s.
+	nums := make([]int, 100)
+	delayed := lazy.Delay(ctx, lazy.NewSlice(ctx, nums), 50*time.Millisecond)
+
+	time.AfterFunc(120*time.Millisecond, cancel)
+	start := time.Now()
+	count := 0
+	if err := lazy.Consume(delayed, func(int) error {
+		count++
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("stream ran for %v after cancellation", elapsed)
+	}
+	if count >= len(nums) {
+		t.Fatalf("expected cancellation to cut the stream short, got all %d values", count)
+	}
+}
@@ -0,0 +1,123 @@
+package lazy
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+)
+
+// DiskReplay holds a stream spilled to a temporary file by CacheToDisk so it
+// can be replayed any number of times without keeping it in memory.
+type DiskReplay[T any] struct {
+	path   string
+	count  int
+	decode func(io.Reader) (T, error)
+}
+
+// CacheToDisk drains the object into a temporary file, encoding each value
+// with encode, and returns a DiskReplay that decodes it back with decode.
+//
+// Input: ctx, object[T], encode(w, v) error, decode(r) (T, error)
+// Output: (*DiskReplay[T], error)
+// Order: replays values in upstream order
+// Cancellation: guards receives with select on ctx.Done()
+// Errors: the first encode or file error, ctx.Err() if cancelled, or the
+// upstream stage error if a stage hit DecisionStop; no file is left behind
+// on error
+// Buffering: values live on disk, not in memory
+//
+// decode is called exactly once per cached value, so it need not detect the
+// end of the file. Call Close to remove the file.
+func CacheToDisk[T any](ctx context.Context, obj object[T], encode func(w io.Writer, v T) error, decode func(r io.Reader) (T, error)) (*DiskReplay[T], error) {
+	f, err := os.CreateTemp("", "lazy-cache-*")
+	if err != nil {
+		return nil, err
+	}
+	fail := func(err error) (*DiskReplay[T], error) {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+
+	w := bufio.NewWriter(f)
+	count := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return fail(ctx.Err())
+		case v, ok := <-obj.ch:
+			if !ok {
+				if err := obj.errs.get(); err != nil {
+					return fail(err)
+				}
+				if err := w.Flush(); err != nil {
+					return fail(err)
+				}
+				if err := f.Close(); err != nil {
+					return fail(err)
+				}
+				return &DiskReplay[T]{path: f.Name(), count: count, decode: decode}, nil
+			}
+			if err := encode(w, v); err != nil {
+				return fail(err)
+			}
+			count++
+		}
+	}
+}
+
+// Stream replays the cached values by re-reading the file.
+//
+// Input: ctx
+// Output: object[T]
+// Order: preserves the cached order
+// Cancellation: stops emission when ctx.Done() or Stop() is called
+// Errors: a file or decode error ends the stream and is recorded as the
+// stream's error (see Consume)
+// Buffering: output channel capacity via WithSize
+func (d *DiskReplay[T]) Stream(ctx context.Context, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	ch := make(chan T, opt.bufferSize(0))
+	done, stop := newStopper()
+	errs := newErrCell()
+
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		f, err := os.Open(d.path)
+		if err != nil {
+			errs.set(0, err)
+			return
+		}
+		defer f.Close()
+		r := bufio.NewReader(f)
+		for i := 0; i < d.count; i++ {
+			v, err := d.decode(r)
+			if err != nil {
+				errs.set(0, err)
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:   ch,
+		stop: stop,
+		errs: errs,
+	}
+}
+
+// Close removes the cache file. Streams started afterwards fail.
+func (d *DiskReplay[T]) Close() error {
+	return os.Remove(d.path)
+}
@@ -0,0 +1,71 @@
+package lazy_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func encodeInt(w io.Writer, v int) error {
+	return binary.Write(w, binary.LittleEndian, int64(v))
+}
+
+func decodeInt(r io.Reader) (int, error) {
+	var v int64
+	err := binary.Read(r, binary.LittleEndian, &v)
+	return int(v), err
+}
+
+func TestCacheToDisk_ReplaysTwice(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cache, err := lazy.CacheToDisk(ctx, lazy.NewSlice(ctx, []int{5, 3, 8, 1}), encodeInt, decodeInt)
+	if err != nil {
+		t.Fatalf("cache error: %v", err)
+	}
+	want := []int{5, 3, 8, 1}
+	for pass := 1; pass <= 2; pass++ {
+		var got []int
+		err := lazy.ConsumeE(cache.Stream(ctx), func(v int) error {
+			got = append(got, v)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("pass %d: replay error: %v", pass, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("pass %d: unexpected result. got=%v want=%v", pass, got, want)
+		}
+	}
+
+	if err := cache.Close(); err != nil {
+		t.Fatalf("close error: %v", err)
+	}
+	err = lazy.ConsumeE(cache.Stream(ctx), func(int) error { return nil })
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected missing file after close, got %v", err)
+	}
+}
+
+func TestCacheToDisk_EncodeError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	_, err := lazy.CacheToDisk(ctx, lazy.NewSlice(ctx, []int{1, 2}), func(io.Writer, int) error {
+		return boom
+	}, decodeInt)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package lazy
+
+import "context"
+
+// Distinct drops values that have been seen before anywhere in the stream.
+//
+// Input: object[T comparable]
+// Output: object[T] (first occurrence of each value)
+// Order: preserves input order of first occurrences
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: ErrBufferExceeded past WithMaxBufferedItems; stops unless
+// WithErrHandler is given
+// Buffering: output channel capacity via WithSize
+//
+// The seen-set grows with every distinct value, so memory is unbounded on
+// long streams unless capped with WithMaxBufferedItems; see
+// DistinctUntilChanged for a constant-memory variant.
+func Distinct[T comparable](ctx context.Context, obj object[T], opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		seen := make(map[T]struct{})
+		for v := range obj.ch {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			if opt.exceedsBuffer(len(seen)) {
+				if decision := opt.handleBufferExceeded(); decision == DecisionStop {
+					obj.errs.set(stage, ErrBufferExceeded)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			seen[v] = struct{}{}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
+
+// DistinctUntilChanged drops values equal to the immediately preceding one.
+//
+// Input: object[T comparable]
+// Output: object[T] (runs of equal values collapsed to one)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize; constant extra memory
+func DistinctUntilChanged[T comparable](ctx context.Context, obj object[T], opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		var prev T
+		first := true
+		for v := range obj.ch {
+			if !first && v == prev {
+				continue
+			}
+			first, prev = false, v
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,62 @@
+package lazy
+
+import (
+	"container/list"
+	"context"
+)
+
+// DistinctByLRU drops values whose key is among the maxKeys most recently
+// seen keys.
+//
+// Input: object[T], maxKeys (values < 1 are treated as 1), keyFn(T) K
+// Output: object[T] (first occurrence of each tracked key)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize; at most maxKeys keys
+//
+// Unlike Distinct, memory stays bounded on infinite streams, at the cost of
+// exactness: a key not seen for maxKeys other distinct keys is evicted, and a
+// later duplicate of it is emitted again. A repeated key counts as a use and
+// is kept.
+func DistinctByLRU[T any, K comparable](ctx context.Context, obj object[T], maxKeys int, keyFn func(v T) K, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+	maxKeys = max(maxKeys, 1)
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		// recent orders keys from most to least recently seen.
+		recent := list.New()
+		index := make(map[K]*list.Element, maxKeys)
+		for v := range obj.ch {
+			k := keyFn(v)
+			if e, ok := index[k]; ok {
+				recent.MoveToFront(e)
+				continue
+			}
+			if recent.Len() == maxKeys {
+				oldest := recent.Back()
+				recent.Remove(oldest)
+				delete(index, oldest.Value.(K))
+			}
+			index[k] = recent.PushFront(k)
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
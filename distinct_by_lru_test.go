@@ -0,0 +1,46 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestDistinctByLRU_EvictedKeyEmittedAgain(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	words := lazy.NewSlice(ctx, []string{"a", "a", "b", "c", "a", "c"})
+	deduped := lazy.DistinctByLRU(ctx, words, 2, func(s string) string { return s })
+
+	got, err := lazy.Collect(deduped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	// "a" is evicted by "b" and "c", so it passes again; "c" is still tracked.
+	if want := []string{"a", "b", "c", "a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestDistinctByLRU_RepeatRefreshesKey(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 1, 3, 1, 2})
+	deduped := lazy.DistinctByLRU(ctx, nums, 2, func(v int) int { return v })
+
+	got, err := lazy.Collect(deduped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	// Seeing 1 again keeps it recent, so 3 evicts 2 instead.
+	if want := []int{1, 2, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,59 @@
+package lazy
+
+import "context"
+
+// DistinctFunc drops values that equal any previously emitted value.
+//
+// Input: object[T], equal(a, b T) bool
+// Output: object[T] (first occurrence of each equivalence class)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: ErrBufferExceeded past WithMaxBufferedItems; stops unless
+// WithErrHandler is given
+// Buffering: output channel capacity via WithSize
+//
+// Unlike a map-based dedup, equal need not be an exact equivalence (e.g.
+// floats within a tolerance). Each value is compared against every value
+// emitted so far, so the cost is O(n) per item and O(n) memory overall.
+func DistinctFunc[T any](ctx context.Context, obj object[T], equal func(a, b T) bool, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		var seen []T
+	next:
+		for v := range obj.ch {
+			for _, s := range seen {
+				if equal(s, v) {
+					continue next
+				}
+			}
+			if opt.exceedsBuffer(len(seen)) {
+				if decision := opt.handleBufferExceeded(); decision == DecisionStop {
+					obj.errs.set(stage, ErrBufferExceeded)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			seen = append(seen, v)
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,55 @@
+package lazy_test
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestDistinctFunc_FloatTolerance(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []float64{1.0, 1.0001, 2.0, 1.9999, 3.0, 1.0})
+	uniq := lazy.DistinctFunc(ctx, nums, func(a, b float64) bool {
+		return math.Abs(a-b) < 0.01
+	})
+
+	var got []float64
+	if err := lazy.Consume(uniq, func(v float64) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []float64{1.0, 2.0, 3.0}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestDistinctFunc_EmptyInput(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{})
+	uniq := lazy.DistinctFunc(ctx, nums, func(a, b int) bool { return a == b })
+
+	consumed := 0
+	if err := lazy.Consume(uniq, func(v int) error {
+		consumed++
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if consumed != 0 {
+		t.Fatalf("expected 0 items, got %d", consumed)
+	}
+}
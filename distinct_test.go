@@ -0,0 +1,40 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestDistinct_Global(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.Distinct(ctx, lazy.NewSlice(ctx, []int{1, 1, 2, 2, 1})))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []int{1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestDistinctUntilChanged_Consecutive(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.DistinctUntilChanged(ctx, lazy.NewSlice(ctx, []int{1, 1, 2, 2, 1})))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []int{1, 2, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
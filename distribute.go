@@ -0,0 +1,54 @@
+package lazy
+
+import "context"
+
+// Distribute partitions one stream across n streams in round-robin order.
+//
+// Input: object[T], n (values < 1 are treated as 1)
+// Output: []object[T] of length n; value i goes to stream i % n
+// Order: preserves input order within each output stream
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: each output channel's capacity via WithSize
+//
+// Every value goes to exactly one output, so all outputs must be consumed
+// concurrently: a stalled output blocks the others once its buffer is full.
+func Distribute[T any](ctx context.Context, obj object[T], n int, opts ...optionFunc) []object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	if n < 1 {
+		n = 1
+	}
+	chs := make([]chan T, n)
+	outs := make([]object[T], n)
+	for i := range chs {
+		chs[i] = make(chan T, opt.bufferSize(stage))
+		outs[i] = object[T]{
+			ch:    chs[i],
+			stage: stage,
+			errs:  obj.errs,
+		}
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer func() {
+			for _, ch := range chs {
+				close(ch)
+			}
+		}()
+		i := 0
+		for v := range obj.ch {
+			select {
+			case <-ctx.Done():
+				return
+			case chs[i] <- v:
+			}
+			i = (i + 1) % n
+		}
+	}()
+
+	return outs
+}
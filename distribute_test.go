@@ -0,0 +1,36 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestDistribute_RoundRobin(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6})
+	outs := lazy.Distribute(ctx, nums, 3)
+
+	got := make([][]int, len(outs))
+	var wg sync.WaitGroup
+	for i, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i], _ = lazy.Collect(out)
+		}()
+	}
+	wg.Wait()
+
+	want := [][]int{{1, 4}, {2, 5}, {3, 6}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
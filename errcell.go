@@ -0,0 +1,73 @@
+package lazy
+
+import (
+	"fmt"
+	"sync"
+)
+
+// errCell holds the first error that stopped a stage. Cells of multi-input
+// operators link to their inputs' cells so upstream errors stay visible.
+// All methods are safe on a nil cell.
+type errCell struct {
+	mu      sync.Mutex
+	err     error
+	parents []*errCell
+}
+
+func newErrCell(parents ...*errCell) *errCell {
+	return &errCell{parents: parents}
+}
+
+// set records err, tagged with the stage that hit it, unless an error was
+// already recorded.
+func (c *errCell) set(stage int, err error) {
+	if c == nil || err == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.err = &StageError{Stage: stage, Err: err}
+	}
+}
+
+// link adds an input cell discovered after construction.
+func (c *errCell) link(parent *errCell) {
+	if c == nil || parent == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.parents = append(c.parents, parent)
+}
+
+// get returns the recorded error, or the first one found among the inputs.
+func (c *errCell) get() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	err, parents := c.err, c.parents
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	for _, p := range parents {
+		if err := p.get(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StageError is the error a stage recorded when it stopped. Stage is the
+// stage's zero-based index in the chain (sources are 0); errors.Is and
+// errors.As see through to Err.
+type StageError struct {
+	Stage int
+	Err   error
+}
+
+func (e *StageError) Error() string { return fmt.Sprintf("stage[%d]: %v", e.Stage, e.Err) }
+
+func (e *StageError) Unwrap() error { return e.Err }
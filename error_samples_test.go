@@ -0,0 +1,43 @@
+package lazy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithErrorSamples_KeepsFirstN(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var in []int
+	for i := range 100 {
+		in = append(in, i)
+	}
+	sampling, samples := lazy.WithErrorSamples(3)
+	mapped := lazy.Map(ctx, lazy.NewSlice(ctx, in), func(v int) (int, error) {
+		return 0, fmt.Errorf("bad %d", v)
+	}, sampling)
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected every value to fail, got %v", got)
+	}
+
+	kept := samples()
+	if len(kept) != 3 {
+		t.Fatalf("expected 3 samples, got %d", len(kept))
+	}
+	for i, s := range kept {
+		if s.Input != i || s.Err.Error() != fmt.Sprintf("bad %d", i) {
+			t.Fatalf("unexpected sample %d: %+v", i, s)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package lazy
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidAlpha is reported by EWMA when alpha is outside (0, 1].
+var ErrInvalidAlpha = errors.New("lazy: alpha must be in (0, 1]")
+
+// EWMA emits the exponentially weighted moving average of the values.
+//
+// Input: object[T] of numbers, alpha in (0, 1] (weight of the newest value)
+// Output: object[float64] (one average per input value)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: an alpha outside (0, 1] records ErrInvalidAlpha and closes the
+// output without reading the input
+// Buffering: output channel capacity via WithSize
+//
+// The average is seeded with the first value and then updated as
+// alpha*v + (1-alpha)*prev. On an invalid alpha EWMA calls Stop on its input,
+// which halts a NewSlice/New source directly upstream; deeper producers stay
+// blocked until ctx is cancelled.
+func EWMA[T Number](ctx context.Context, obj object[T], alpha float64, opts ...optionFunc) object[float64] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan float64, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		if !(alpha > 0 && alpha <= 1) {
+			obj.errs.set(stage, ErrInvalidAlpha)
+			obj.Stop()
+			return
+		}
+		var avg float64
+		first := true
+		for v := range obj.ch {
+			if first {
+				avg, first = float64(v), false
+			} else {
+				avg = alpha*float64(v) + (1-alpha)*avg
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- avg:
+			}
+		}
+	}()
+
+	return object[float64]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,44 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestEWMA_HalfAlpha(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{10, 20, 20, 0})
+	got, err := lazy.Collect(lazy.EWMA(ctx, nums, 0.5))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []float64{10, 15, 17.5, 8.75}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestEWMA_InvalidAlpha(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, alpha := range []float64{0, -0.5, 1.5} {
+		nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+		count := 0
+		err := lazy.ConsumeE(lazy.EWMA(ctx, nums, alpha), func(float64) error {
+			count++
+			return nil
+		})
+		if !errors.Is(err, lazy.ErrInvalidAlpha) || count != 0 {
+			t.Fatalf("alpha=%v: got count=%d err=%v, want 0 and ErrInvalidAlpha", alpha, count, err)
+		}
+	}
+}
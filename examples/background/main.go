@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/iwanhae/lazy"
+)
+
+// The BG constructors skip the context for pipelines that always run to
+// completion. Nothing here can be cancelled, so every value is consumed.
+func main() {
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	nums := lazy.NewSliceBG(a, lazy.WithSize(5))
+	filtered := lazy.FilterBG(nums, func(v int) (bool, error) {
+		return v <= 5, nil
+	})
+	doubled := lazy.MapBG(filtered, func(v int) (int, error) {
+		return v * 2, nil
+	}, lazy.WithSize(1))
+
+	if err := lazy.Consume(doubled, func(v int) error {
+		fmt.Println(v)
+		return nil
+	}); err != nil {
+		fmt.Println("err:", err)
+	}
+}
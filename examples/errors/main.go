@@ -25,8 +25,10 @@ func main() {
 	}))
 
 	fmt.Println("results (should stop before 3):")
-	_ = lazy.Consume(mapped, func(v int) error {
+	if err := lazy.Consume(mapped, func(v int) error {
 		fmt.Println(v)
 		return nil
-	})
+	}); err != nil {
+		fmt.Println("err:", err)
+	}
 }
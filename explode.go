@@ -0,0 +1,47 @@
+package lazy
+
+import "context"
+
+// Explode emits one key/value entry per map key for each incoming map.
+//
+// Input: object[map[K]V]
+// Output: object[struct{Key K; Value V}]
+// Order: maps are exploded in input order; entries within one map follow
+// Go map iteration order, which is unspecified
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+func Explode[K comparable, V any](ctx context.Context, obj object[map[K]V], opts ...optionFunc) object[struct {
+	Key   K
+	Value V
+}] {
+	type entry = struct {
+		Key   K
+		Value V
+	}
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan entry, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for m := range obj.ch {
+			for k, v := range m {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- entry{Key: k, Value: v}:
+				}
+			}
+		}
+	}()
+
+	return object[entry]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
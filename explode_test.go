@@ -0,0 +1,37 @@
+package lazy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestExplode_EmitsEveryEntry(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	maps := lazy.NewSlice(ctx, []map[string]int{
+		{"a": 1, "b": 2},
+		{},
+		{"c": 3},
+	})
+	entries := lazy.Explode(ctx, maps)
+
+	got := map[string]int{}
+	if err := lazy.Consume(entries, func(e struct {
+		Key   string
+		Value int
+	}) error {
+		got[e.Key] = e.Value
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	if len(got) != 3 || got["a"] != 1 || got["b"] != 2 || got["c"] != 3 {
+		t.Fatalf("unexpected entries: %v", got)
+	}
+}
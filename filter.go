@@ -8,24 +8,35 @@ import "context"
 // Output: object[T] (accepted values pass through)
 // Order: preserves input order for emitted values
 // Cancellation: guards sends with select on ctx.Done()
-// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore (after WithRetry attempts)
 // Buffering: output channel capacity via WithSize
 func Filter[T any](ctx context.Context, obj object[T], predicate func(v T) (bool, error), opts ...optionFunc) object[T] {
 	opt := buildOpts(opts)
-	ch := make(chan T, opt.size)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
 
+	opt.group.add(obj.errs)
 	go func() {
+		defer opt.group.done()
 		defer recover()
 		defer close(ch)
 		for v := range obj.ch {
-			ok, err := predicate(v)
+			if !opt.controller.wait(ctx) {
+				return
+			}
+			ok, err := retry(ctx, &opt, func() (bool, error) { return guarded(&opt, v, predicate) })
 			if err != nil {
-				if decision := opt.onError(err); decision == DecisionStop {
+				opt.samples.record(v, err)
+				opt.observer.OnError(err)
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
 					return
 				}
 				// DecisionIgnore: drop value and continue
+				opt.observer.OnDrop()
 				continue
 			}
+			opt.handleSuccess()
 			if !ok {
 				// Filtered out
 				continue
@@ -36,10 +47,13 @@ func Filter[T any](ctx context.Context, obj object[T], predicate func(v T) (bool
 				return
 			case ch <- v:
 			}
+			opt.observer.OnEmit()
 		}
 	}()
 
 	return object[T]{
-		ch: ch,
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
 	}
 }
@@ -76,11 +76,12 @@ func TestFilter_StopOnError(t *testing.T) {
 	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
 
 	var got []int
+	var stageErr *lazy.StageError
 	if err := lazy.Consume(filtered, func(v int) error {
 		got = append(got, v)
 		return nil
-	}); err != nil {
-		t.Fatalf("consume error: %v", err)
+	}); !errors.As(err, &stageErr) || stageErr.Stage != 1 {
+		t.Fatalf("expected stage 1 error, got %v", err)
 	}
 
 	// Should only get values before the error (1, 2)
@@ -0,0 +1,56 @@
+package lazy
+
+import "context"
+
+// First returns the first value of the object.
+//
+// Input: ctx, object[T]
+// Output: (T, bool, error) the value and true, or the zero value and false
+// if the stream closed empty
+// Order: N/A
+// Cancellation: guards the receive with select on ctx.Done()
+// Errors: ctx.Err() if cancelled first; if the stream closed empty because a
+// stage hit DecisionStop, that stage's error
+// Buffering: N/A
+//
+// First stops reading after one value and calls Stop on the object, which
+// halts a NewSlice/New source directly upstream. Deeper producers stay
+// blocked until ctx is cancelled, so cancel it once First returns.
+func First[T any](ctx context.Context, obj object[T]) (T, bool, error) {
+	return Find(ctx, obj, func(T) (bool, error) { return true, nil })
+}
+
+// Find returns the first value of the object satisfying pred.
+//
+// Input: ctx, object[T], pred(T) (bool, error)
+// Output: (T, bool, error) the match and true, or the zero value and false
+// Order: checks values in upstream order
+// Cancellation: guards receives with select on ctx.Done()
+// Errors: the first pred error; ctx.Err() if cancelled first; if the stream
+// closed without a match because a stage hit DecisionStop, that stage's error
+// Buffering: N/A
+//
+// Like First, Find stops reading once it has an answer and calls Stop on the
+// object; cancel ctx afterwards to release deeper producers.
+func Find[T any](ctx context.Context, obj object[T], pred func(v T) (bool, error)) (T, bool, error) {
+	var zero T
+	for {
+		select {
+		case <-ctx.Done():
+			return zero, false, ctx.Err()
+		case v, ok := <-obj.ch:
+			if !ok {
+				return zero, false, obj.errs.get()
+			}
+			match, err := pred(v)
+			if err != nil {
+				obj.Stop()
+				return zero, false, err
+			}
+			if match {
+				obj.Stop()
+				return v, true, nil
+			}
+		}
+	}
+}
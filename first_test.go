@@ -0,0 +1,68 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestFind_LocatesMatch(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	v, ok, err := lazy.Find(ctx, nums, func(v int) (bool, error) { return v == 4, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ok || v != 4 {
+		t.Fatalf("got (%d, %v), want (4, true)", v, ok)
+	}
+}
+
+func TestFind_PredicateError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	_, ok, err := lazy.Find(ctx, nums, func(v int) (bool, error) {
+		if v == 2 {
+			return false, boom
+		}
+		return false, nil
+	})
+	if ok || !errors.Is(err, boom) {
+		t.Fatalf("got (%v, %v), want (false, %v)", ok, err, boom)
+	}
+}
+
+func TestFirst_EmptyStream(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	v, ok, err := lazy.First(ctx, lazy.NewSlice(ctx, []int{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok || v != 0 {
+		t.Fatalf("got (%d, %v), want (0, false)", v, ok)
+	}
+}
+
+func TestFirst_InfiniteSource(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	v, ok, err := lazy.First(ctx, lazy.New(ctx, naturals(ctx)))
+	if err != nil || !ok || v != 0 {
+		t.Fatalf("got (%d, %v, %v), want (0, true, nil)", v, ok, err)
+	}
+}
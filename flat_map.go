@@ -0,0 +1,52 @@
+package lazy
+
+import "context"
+
+// FlatMap expands each input value into zero or more outputs.
+//
+// Input: object[IN], mapper(IN) ([]OUT, error)
+// Output: object[OUT] (elements of each returned slice, in slice order)
+// Order: preserves input order for emitted values
+// Cancellation: guards every send with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore;
+// Ignore drops the whole input element
+// Buffering: output channel capacity via WithSize
+//
+// A nil or empty slice from mapper emits nothing for that input.
+func FlatMap[IN any, OUT any](ctx context.Context, obj object[IN], mapper func(v IN) ([]OUT, error), opts ...optionFunc) object[OUT] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan OUT, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for v := range obj.ch {
+			results, err := mapper(v)
+			if err != nil {
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			opt.handleSuccess()
+			for _, result := range results {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- result:
+				}
+			}
+		}
+	}()
+
+	return object[OUT]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
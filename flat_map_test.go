@@ -0,0 +1,91 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestFlatMap_SplitsLines(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lines := lazy.NewSlice(ctx, []string{"a b", "", "c"})
+	tokens := lazy.FlatMap(ctx, lines, func(s string) ([]string, error) {
+		return strings.Fields(s), nil
+	})
+
+	var got []string
+	if err := lazy.Consume(tokens, func(v string) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestFlatMap_DefaultIgnoreError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	out := lazy.FlatMap(ctx, nums, func(v int) ([]int, error) {
+		if v == 2 {
+			return []int{20, 21}, errors.New("partial")
+		}
+		return []int{v, v}, nil
+	})
+
+	var got []int
+	if err := lazy.Consume(out, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []int{1, 1, 3, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestFlatMap_StopOnError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	out := lazy.FlatMap(ctx, nums, func(v int) ([]int, error) {
+		if v == 2 {
+			return nil, errors.New("boom")
+		}
+		return []int{v, v}, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var got []int
+	var stageErr *lazy.StageError
+	if err := lazy.Consume(out, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); !errors.As(err, &stageErr) || stageErr.Stage != 1 {
+		t.Fatalf("expected stage 1 error, got %v", err)
+	}
+
+	want := []int{1, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package lazy
+
+import "context"
+
+// Flatten un-nests a stream of slices into a stream of their elements.
+//
+// Input: object[[]T]
+// Output: object[T] (elements of each slice, in slice order)
+// Order: preserves input order for emitted values
+// Cancellation: guards every send with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// Nil and empty slices contribute nothing.
+func Flatten[T any](ctx context.Context, obj object[[]T], opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for vs := range obj.ch {
+			for _, v := range vs {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- v:
+				}
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
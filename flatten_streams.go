@@ -0,0 +1,68 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+// FlattenStreams merges a stream of streams, draining up to concurrency
+// inner streams at once.
+//
+// Input: object[object[T]], concurrency (values <= 0 are treated as 1)
+// Output: object[T] (values of all inner streams)
+// Order: preserved within each inner stream; interleaved across inner streams
+// Cancellation: guards sends with select on ctx.Done(); stops taking new inner streams
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// The output closes only after the outer stream and every started inner
+// stream are done. On cancellation, inner streams are abandoned mid-way; tie
+// their producers to the same ctx so they exit too.
+func FlattenStreams[T any](ctx context.Context, obj object[object[T]], concurrency int, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	errs := newErrCell(obj.errs)
+	ch := make(chan T, opt.bufferSize(stage))
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		var wg sync.WaitGroup
+		defer close(ch)
+		defer wg.Wait()
+
+		sem := make(chan struct{}, concurrency)
+		for inner := range obj.ch {
+			select {
+			case <-ctx.Done():
+				return
+			case sem <- struct{}{}:
+			}
+
+			errs.link(inner.errs)
+			wg.Add(1)
+			go func(inner object[T]) {
+				defer recover()
+				defer wg.Done()
+				defer func() { <-sem }()
+				for v := range inner.ch {
+					select {
+					case <-ctx.Done():
+						return
+					case ch <- v:
+					}
+				}
+			}(inner)
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
@@ -0,0 +1,76 @@
+package lazy
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"go.uber.org/goleak"
+)
+
+// Whitebox: object[object[T]] cannot be spelled from package lazy_test.
+func TestFlattenStreams_MergesAllInner(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	inner := []object[int]{
+		NewSlice(ctx, []int{1, 2}),
+		NewSlice(ctx, []int{3, 4, 5}),
+		NewSlice(ctx, []int{6}),
+		NewSlice(ctx, []int{7, 8}),
+	}
+	flat := FlattenStreams(ctx, NewSlice(ctx, inner), 2)
+
+	var got []int
+	if err := Consume(flat, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	slices.Sort(got)
+	want := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestFlattenStreams_CancellationStopsInner(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var big []int
+	for i := 0; i < 100000; i++ {
+		big = append(big, i)
+	}
+	inner := []object[int]{
+		NewSlice(ctx, big),
+		NewSlice(ctx, big),
+		NewSlice(ctx, big),
+	}
+	flat := FlattenStreams(ctx, NewSlice(ctx, inner), 2)
+
+	done := make(chan struct{})
+	count := 0
+	go func() {
+		_ = Consume(flat, func(v int) error {
+			count++
+			if count == 5 {
+				cancel()
+			}
+			return nil
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("pipeline did not stop after cancellation")
+	}
+}
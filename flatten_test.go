@@ -0,0 +1,25 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestFlatten_UnnestsSlices(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nested := lazy.NewSlice(ctx, [][]int{{1, 2}, {}, nil, {3}})
+	got, err := lazy.Collect(lazy.Flatten(ctx, nested, lazy.WithSize(1)))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,143 @@
+package lazy
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Group is the task-registration half of *errgroup.Group. Any type with a
+// Go(func() error) method (including *errgroup.Group) satisfies it, so the
+// package does not depend on golang.org/x/sync.
+type Group interface {
+	Go(f func() error)
+}
+
+// RunInGroup registers draining obj with consumer as a task of g.
+//
+// Input: g Group, object[T], consumer func(T) error
+// Output: none; the task result is reported through g (e.g. errgroup Wait)
+// Order: consumes values in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: the task returns what Consume returns: the first consumer error,
+// else the error that stopped an upstream stage
+// Buffering: N/A
+//
+// When g is an errgroup created with WithContext, build the pipeline with
+// that context so a failure in any task also stops the lazy stages.
+func RunInGroup[T any](g Group, obj object[T], consumer func(v T) error) {
+	g.Go(func() error {
+		return Consume(obj, consumer)
+	})
+}
+
+// PipelineGroup waits for the goroutines of every stage attached to it via
+// WithGroup and reports the first error that stopped one of them. Operators
+// that take several objects and no options (Merge, Concat, ZipN, Race, ...)
+// cannot be attached.
+//
+// Create it with NewPipelineGroup and build the pipeline on the context it
+// returns: that context is cancelled as soon as a stage stops on an error or a
+// Go task fails, which releases stages upstream of the failure that would
+// otherwise stay blocked on a send, so Wait returns. The zero value also
+// works but has no such context; Wait then relies on the caller's own
+// cancellation.
+//
+// A PipelineGroup is also a Group, so RunInGroup can add the terminal
+// consumer to the same Wait.
+type PipelineGroup struct {
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	cells  []*errCell
+	err    error
+	cancel context.CancelFunc
+}
+
+// NewPipelineGroup returns a group and a context derived from ctx that is
+// cancelled on the first stage stop or Go error, or once Wait returns.
+func NewPipelineGroup(ctx context.Context) (*PipelineGroup, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &PipelineGroup{cancel: cancel}, ctx
+}
+
+// WithGroup attaches a stage's goroutines to g.
+func WithGroup(g *PipelineGroup) optionFunc {
+	return func(opts *option) {
+		opts.group = g
+	}
+}
+
+// Go runs f in a goroutine tracked by Wait; its error is reported when no
+// stage stopped on one.
+func (g *PipelineGroup) Go(f func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := f(); err != nil {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			if g.err == nil {
+				g.err = err
+			}
+			g.cancelLocked()
+		}
+	}()
+}
+
+// Wait blocks until every attached stage goroutine and every Go task has
+// returned. It returns the first error that stopped a stage (a *StageError,
+// upstream stages first), else the first Go error, else nil. Build the whole
+// pipeline before calling Wait.
+func (g *PipelineGroup) Wait() error {
+	g.wg.Wait()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.cancelLocked()
+	if err := g.stageErrLocked(); err != nil {
+		return err
+	}
+	return g.err
+}
+
+// add registers one stage goroutine and the error cell it records into.
+// Safe on a nil group.
+func (g *PipelineGroup) add(errs *errCell) {
+	if g == nil {
+		return
+	}
+	g.wg.Add(1)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !slices.Contains(g.cells, errs) {
+		g.cells = append(g.cells, errs)
+	}
+}
+
+// done marks a stage goroutine registered with add as finished, cancelling
+// the group context if a stage has recorded an error.
+func (g *PipelineGroup) done() {
+	if g == nil {
+		return
+	}
+	defer g.wg.Done()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.stageErrLocked() != nil {
+		g.cancelLocked()
+	}
+}
+
+func (g *PipelineGroup) stageErrLocked() error {
+	for _, c := range g.cells {
+		if err := c.get(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *PipelineGroup) cancelLocked() {
+	if g.cancel != nil {
+		g.cancel()
+	}
+}
@@ -0,0 +1,36 @@
+package lazy
+
+// GroupBy drains the object into buckets keyed by keyFn.
+//
+// Input: object[T], keyFn(T) (K, error)
+// Output: (map[K][]T, error) values per key; non-nil even when empty
+// Order: each bucket preserves upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: returns the first keyFn error together with the map built from the
+// values before the failing one; if the stream closed because a stage hit
+// DecisionStop, that stage's error together with the groups so far;
+// ErrBufferExceeded past WithMaxBufferedItems, unless WithErrHandler is given,
+// in which case DecisionIgnore drops the value
+// Buffering: retains every value, up to WithMaxBufferedItems; see
+// GroupByStream for a streaming variant
+func GroupBy[T any, K comparable](obj object[T], keyFn func(v T) (K, error), opts ...optionFunc) (map[K][]T, error) {
+	opt := buildOpts(opts)
+	groups := make(map[K][]T)
+	retained := 0
+	for v := range obj.ch {
+		k, err := keyFn(v)
+		if err != nil {
+			return groups, err
+		}
+		if opt.exceedsBuffer(retained) {
+			if decision := opt.handleBufferExceeded(); decision == DecisionStop {
+				return groups, ErrBufferExceeded
+			}
+			// DecisionIgnore: drop value and continue
+			continue
+		}
+		groups[k] = append(groups[k], v)
+		retained++
+	}
+	return groups, obj.errs.get()
+}
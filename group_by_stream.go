@@ -0,0 +1,71 @@
+package lazy
+
+import "context"
+
+// GroupByStream splits a stream into one live sub-stream per key.
+//
+// Input: object[T], key(T) K
+// Output: object[struct{Key K; Stream object[T]}] (one entry per distinct key,
+// emitted when the key is first seen)
+// Order: groups in order of first appearance; values keep input order within
+// their sub-stream
+// Cancellation: guards every send with select on ctx.Done()
+// Errors: none
+// Buffering: the output and every sub-stream's capacity via WithSize
+//
+// Each value is routed into its key's sub-stream, and all sub-streams close
+// when the input ends. Sub-streams must be drained concurrently with the
+// output and with each other: one that is not read stalls the whole stage
+// once its buffer is full. The set of keys is retained for the lifetime of
+// the stream.
+func GroupByStream[T any, K comparable](ctx context.Context, obj object[T], key func(v T) K, opts ...optionFunc) object[struct {
+	Key    K
+	Stream object[T]
+}] {
+	type group = struct {
+		Key    K
+		Stream object[T]
+	}
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan group, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		subs := make(map[K]chan T)
+		defer func() {
+			for _, sub := range subs {
+				close(sub)
+			}
+			close(ch)
+		}()
+		for v := range obj.ch {
+			k := key(v)
+			sub, ok := subs[k]
+			if !ok {
+				sub = make(chan T, opt.bufferSize(stage))
+				subs[k] = sub
+				g := group{Key: k, Stream: object[T]{ch: sub, stage: stage, errs: obj.errs}}
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- g:
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case sub <- v:
+			}
+		}
+	}()
+
+	return object[group]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
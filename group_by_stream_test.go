@@ -0,0 +1,50 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestGroupByStream_SplitsByParity(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7})
+	groups := lazy.GroupByStream(ctx, nums, func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	got := map[string][]int{}
+	var order []string
+	for g := range lazy.Seq(groups) {
+		order = append(order, g.Key)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vs, _ := lazy.Collect(g.Stream)
+			mu.Lock()
+			got[g.Key] = vs
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if want := []string{"odd", "even"}; !reflect.DeepEqual(order, want) {
+		t.Fatalf("unexpected group order %v", order)
+	}
+	want := map[string][]int{"odd": {1, 3, 5, 7}, "even": {2, 4, 6}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected groups. got=%v want=%v", got, want)
+	}
+}
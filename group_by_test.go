@@ -0,0 +1,74 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestGroupBy_BucketsByParity(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6})
+	got, err := lazy.GroupBy(nums, func(v int) (int, error) { return v % 2, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[int][]int{0: {2, 4, 6}, 1: {1, 3, 5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestGroupBy_KeyErrorReturnsPartial(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	got, err := lazy.GroupBy(nums, func(v int) (int, error) {
+		if v == 3 {
+			return 0, boom
+		}
+		return v % 2, nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	want := map[int][]int{0: {2}, 1: {1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected partial result. got=%v want=%v", got, want)
+	}
+}
+
+func TestGroupBy_MaxBufferedItems(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4})
+	got, err := lazy.GroupBy(nums, func(v int) (int, error) { return v % 2, nil }, lazy.WithMaxBufferedItems(3))
+	if !errors.Is(err, lazy.ErrBufferExceeded) {
+		t.Fatalf("expected ErrBufferExceeded, got %v", err)
+	}
+	if want := map[int][]int{0: {2}, 1: {1, 3}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected partial result. got=%v want=%v", got, want)
+	}
+
+	nums = lazy.NewSlice(ctx, []int{1, 2, 3, 4})
+	got, err = lazy.GroupBy(nums, func(v int) (int, error) { return v % 2, nil }, lazy.WithMaxBufferedItems(3),
+		lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionIgnore }))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := map[int][]int{0: {2}, 1: {1, 3}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,123 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// testGroup mirrors errgroup.Group: Wait returns the first task error.
+type testGroup struct {
+	wg   sync.WaitGroup
+	once sync.Once
+	err  error
+}
+
+func (g *testGroup) Go(f func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := f(); err != nil {
+			g.once.Do(func() { g.err = err })
+		}
+	}()
+}
+
+func (g *testGroup) Wait() error {
+	g.wg.Wait()
+	return g.err
+}
+
+func TestRunInGroup_SurfacesConsumerError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var g testGroup
+	wantErr := errors.New("stop@2")
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	lazy.RunInGroup(&g, nums, func(v int) error {
+		if v == 2 {
+			return wantErr
+		}
+		return nil
+	})
+	g.Go(func() error { return nil })
+
+	if err := g.Wait(); !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v from Wait, got %v", wantErr, err)
+	}
+}
+
+func TestPipelineGroup_WaitReportsStopError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	g, gctx := lazy.NewPipelineGroup(ctx)
+	boom := errors.New("boom")
+
+	// Unbuffered: the source is still blocked sending 4..8 when Map stops,
+	// and only the group context releases it.
+	nums := lazy.NewSlice(gctx, []int{1, 2, 3, 4, 5, 6, 7, 8}, lazy.WithGroup(g))
+	mapped := lazy.Map(gctx, nums, func(v int) (int, error) {
+		if v == 3 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }), lazy.WithGroup(g))
+	kept := lazy.Filter(gctx, mapped, func(v int) (bool, error) { return true, nil }, lazy.WithGroup(g))
+
+	var got []int
+	lazy.RunInGroup(g, kept, func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+
+	err := g.Wait()
+	var stageErr *lazy.StageError
+	if !errors.As(err, &stageErr) || stageErr.Stage != 1 || !errors.Is(err, boom) {
+		t.Fatalf("expected stage 1 error wrapping %v, got %v", boom, err)
+	}
+	// The cancellation may overtake the last value Map sent before stopping.
+	if want := []int{1, 2}; len(got) > len(want) || !reflect.DeepEqual(got, want[:len(got)]) {
+		t.Fatalf("unexpected result. got=%v want a prefix of %v", got, want)
+	}
+	if gctx.Err() == nil {
+		t.Fatal("expected the group context to be cancelled")
+	}
+	// Every stage goroutine has exited by now, before ctx is cancelled.
+	goleak.VerifyNone(t)
+}
+
+func TestPipelineGroup_WaitBlocksUntilStagesFinish(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var g lazy.PipelineGroup
+	in := make(chan int)
+	src := lazy.New(ctx, in, lazy.WithGroup(&g))
+	doubled := lazy.Map(ctx, src, func(v int) (int, error) { return v * 2, nil }, lazy.WithGroup(&g))
+	lazy.RunInGroup(&g, doubled, func(int) error { return nil })
+
+	waited := make(chan error, 1)
+	go func() { waited <- g.Wait() }()
+
+	in <- 1
+	select {
+	case err := <-waited:
+		t.Fatalf("Wait returned %v while the source was still open", err)
+	default:
+	}
+	close(in)
+	if err := <-waited; err != nil {
+		t.Fatalf("expected nil from Wait, got %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package lazy
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+)
+
+// ToGzipNDJSON drains the object into w as gzip-compressed JSON lines.
+//
+// Input: object[T], w io.Writer
+// Output: (int, error) number of values written, first encode/write error
+// Order: writes values in upstream order, one JSON document per line
+// Cancellation: N/A; respects upstream closure
+// Errors: returns the first encode error; the gzip stream is still closed;
+// if the stream closed because a stage hit DecisionStop, that stage's error
+// Buffering: N/A
+//
+// The gzip writer is closed (flushing its footer) before returning; w itself
+// is left open for the caller.
+func ToGzipNDJSON[T any](obj object[T], w io.Writer) (int, error) {
+	zw := gzip.NewWriter(w)
+	enc := json.NewEncoder(zw)
+	n := 0
+	for v := range obj.ch {
+		if err := enc.Encode(v); err != nil {
+			_ = zw.Close()
+			return n, err
+		}
+		n++
+	}
+	if err := zw.Close(); err != nil {
+		return n, err
+	}
+	return n, obj.errs.get()
+}
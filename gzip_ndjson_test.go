@@ -0,0 +1,60 @@
+package lazy_test
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+type logLine struct {
+	Level string `json:"level"`
+	Msg   string `json:"msg"`
+}
+
+func TestToGzipNDJSON_RoundTrip(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	want := []logLine{{"info", "start"}, {"warn", "slow"}, {"info", "done"}}
+	src := lazy.NewSlice(ctx, want)
+
+	var buf bytes.Buffer
+	n, err := lazy.ToGzipNDJSON(src, &buf)
+	if err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if n != len(want) {
+		t.Fatalf("expected %d written, got %d", len(want), n)
+	}
+
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("gzip reader: %v", err)
+	}
+	defer zr.Close()
+
+	var got []logLine
+	sc := bufio.NewScanner(zr)
+	for sc.Scan() {
+		var l logLine
+		if err := json.Unmarshal(sc.Bytes(), &l); err != nil {
+			t.Fatalf("decode line %q: %v", sc.Text(), err)
+		}
+		got = append(got, l)
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
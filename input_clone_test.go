@@ -0,0 +1,76 @@
+package lazy_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// sharedBuffers returns n slices that all alias the same backing array, the
+// way pooled buffers do.
+func sharedBuffers(n int) [][]int {
+	buf := []int{1, 2, 3}
+	out := make([][]int, n)
+	for i := range out {
+		out[i] = buf
+	}
+	return out
+}
+
+// mutatingSum scribbles over its input before summing it. Without a clone,
+// concurrent calls race on the shared backing array.
+func mutatingSum(v []int) (int, error) {
+	sum := 0
+	for i := range v {
+		v[i] *= 2
+		sum += v[i]
+	}
+	return sum, nil
+}
+
+func TestWithInputClone_ParallelMap(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bufs := lazy.NewSlice(ctx, sharedBuffers(100))
+	sums := lazy.ParallelMap(ctx, bufs, 4, mutatingSum, lazy.WithInputClone(slices.Clone[[]int]))
+
+	got, err := lazy.Collect(sums)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 100 {
+		t.Fatalf("expected 100 results, got %d", len(got))
+	}
+	for _, v := range got {
+		if v != 12 {
+			t.Fatalf("mapper saw a mutated input: sum=%d", v)
+		}
+	}
+}
+
+func TestWithInputClone_ParallelMapOrdered(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bufs := lazy.NewSlice(ctx, sharedBuffers(100))
+	sums := lazy.ParallelMapOrdered(ctx, bufs, 4, mutatingSum, lazy.WithInputClone(slices.Clone[[]int]))
+
+	got, err := lazy.Collect(sums)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 100 {
+		t.Fatalf("expected 100 results, got %d", len(got))
+	}
+	for _, v := range got {
+		if v != 12 {
+			t.Fatalf("mapper saw a mutated input: sum=%d", v)
+		}
+	}
+}
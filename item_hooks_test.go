@@ -0,0 +1,57 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithItemHooks_MeasuresMapperOnly(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type call struct {
+		in, out any
+		err     error
+		dur     time.Duration
+	}
+	var before []any
+	var after []call
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		if v == 2 {
+			return 0, boom
+		}
+		return v * 10, nil
+	}, lazy.WithItemHooks(
+		func(v any) { before = append(before, v) },
+		func(v any, out any, err error, dur time.Duration) {
+			after = append(after, call{in: v, out: out, err: err, dur: dur})
+		},
+	))
+
+	if _, err := lazy.Collect(mapped); err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(before) != 2 || before[0] != 1 || before[1] != 2 {
+		t.Fatalf("unexpected before calls %v", before)
+	}
+	if len(after) != 2 {
+		t.Fatalf("expected 2 after calls, got %d", len(after))
+	}
+	if after[0].out != 10 || after[0].err != nil || after[1].err != boom {
+		t.Fatalf("unexpected after calls %+v", after)
+	}
+	for _, c := range after {
+		if c.dur < 20*time.Millisecond || c.dur > time.Second {
+			t.Fatalf("duration %v does not reflect the mapper's sleep", c.dur)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package lazy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// ToJSONArray drains the object into w as a single JSON array.
+//
+// Input: object[T], w io.Writer
+// Output: (int, error) number of elements written, first encode/write error
+// Order: writes values in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: returns the first encode or write error (the array is left
+// unclosed); if the stream closed because a stage hit DecisionStop, that
+// stage's error (the array is closed)
+// Buffering: N/A; values are written as they arrive, not materialized
+//
+// Elements are encoded with a json.Encoder (HTML-escaping as by default),
+// each into a reused buffer and written to w as soon as it is encoded, so the
+// Encoder's trailing newline can be dropped. An empty stream writes []. No
+// trailing newline is written.
+func ToJSONArray[T any](obj object[T], w io.Writer) (int, error) {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return 0, err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	n := 0
+	for v := range obj.ch {
+		buf.Reset()
+		if n > 0 {
+			buf.WriteByte(',')
+		}
+		if err := enc.Encode(v); err != nil {
+			return n, err
+		}
+		buf.Truncate(buf.Len() - 1) // Encode's trailing newline
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if _, err := io.WriteString(w, "]"); err != nil {
+		return n, err
+	}
+	return n, obj.errs.get()
+}
@@ -0,0 +1,53 @@
+package lazy_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestToJSONArray_Ints(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var buf strings.Builder
+	n, err := lazy.ToJSONArray(lazy.NewSlice(ctx, []int{1, 2, 3}), &buf)
+	if err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if n != 3 || buf.String() != "[1,2,3]" {
+		t.Fatalf("got n=%d json=%q, want n=3 json=%q", n, buf.String(), "[1,2,3]")
+	}
+}
+
+func TestToJSONArray_Empty(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var buf strings.Builder
+	if _, err := lazy.ToJSONArray(lazy.NewSlice(ctx, []string{}), &buf); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if buf.String() != "[]" {
+		t.Fatalf("got %q, want %q", buf.String(), "[]")
+	}
+}
+
+func TestToJSONArray_EncoderEscaping(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var buf strings.Builder
+	if _, err := lazy.ToJSONArray(lazy.NewSlice(ctx, []string{"<b>", "a&b"}), &buf); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if want := `["\u003cb\u003e","a\u0026b"]`; buf.String() != want {
+		t.Fatalf("got %q, want %q", buf.String(), want)
+	}
+}
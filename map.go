@@ -8,34 +8,73 @@ import "context"
 // Output: object[OUT]
 // Order: preserves input order for emitted values
 // Cancellation: guards sends with select on ctx.Done()
-// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore (after WithRetry attempts);
+// WithTimeout turns a hung mapper call into an error
 // Buffering: output channel capacity via WithSize
 func Map[IN any, OUT any](ctx context.Context, obj object[IN], mapper func(v IN) (OUT, error), opts ...optionFunc) object[OUT] {
+	return MapCtx(ctx, obj, func(_ context.Context, v IN) (OUT, error) { return mapper(v) }, opts...)
+}
+
+// MapCtx is Map for mappers that take a context, so IO-bound calls can cancel
+// in-flight work.
+//
+// Input: object[IN], mapper(ctx, IN) (OUT, error)
+// Output: object[OUT]
+// Order: preserves input order for emitted values
+// Cancellation: mapper receives ctx (cancelled early under WithTimeout);
+// guards sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore (after WithRetry attempts);
+// once ctx is cancelled the stage exits without consulting the handler
+// Buffering: output channel capacity via WithSize
+func MapCtx[IN any, OUT any](ctx context.Context, obj object[IN], mapper func(ctx context.Context, v IN) (OUT, error), opts ...optionFunc) object[OUT] {
 	opt := buildOpts(opts)
-	ch := make(chan OUT, opt.size)
+	stage := obj.stage + 1
+	ch := make(chan OUT, opt.bufferSize(stage))
 
+	opt.group.add(obj.errs)
 	go func() {
+		defer opt.group.done()
 		defer recover()
 		defer close(ch)
 		for v := range obj.ch {
-			result, err := mapper(v)
+			if !opt.controller.wait(ctx) {
+				return
+			}
+			result, err := retry(ctx, &opt, func() (OUT, error) {
+				return hooked(&opt, v, func(v IN) (OUT, error) {
+					return timed(ctx, &opt, func(ctx context.Context) (OUT, error) {
+						return guarded(&opt, v, func(v IN) (OUT, error) { return mapper(ctx, v) })
+					})
+				})
+			})
 			if err != nil {
-				if decision := opt.onError(err); decision == DecisionStop {
+				if ctx.Err() != nil {
+					return
+				}
+				opt.samples.record(v, err)
+				opt.observer.OnError(err)
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
 					return
 				}
 				// DecisionIgnore: drop value and continue
+				opt.observer.OnDrop()
 				continue
 			}
+			opt.handleSuccess()
 			// Respect cancellation when forwarding results to the next stage
 			select {
 			case <-ctx.Done():
 				return
 			case ch <- result:
 			}
+			opt.observer.OnEmit()
 		}
 	}()
 
 	return object[OUT]{
-		ch: ch,
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
 	}
 }
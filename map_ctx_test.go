@@ -0,0 +1,70 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestMapCtx_StopsCleanlyOnCancel(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handled := 0
+	nums := lazy.New(ctx, naturals(ctx))
+	fetched := lazy.MapCtx(ctx, nums, func(ctx context.Context, v int) (int, error) {
+		if v == 3 {
+			cancel()
+		}
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision {
+		handled++
+		return lazy.DecisionStop
+	}))
+
+	err := lazy.ConsumeE(fetched, func(int) error { return nil })
+	if err != nil {
+		t.Fatalf("expected a clean stop, got %v", err)
+	}
+	if handled != 0 {
+		t.Fatalf("handler saw %d cancellation errors", handled)
+	}
+}
+
+func TestMapCtx_TimeoutCancelsMapperContext(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	released := make(chan error, 1)
+	nums := lazy.NewSlice(ctx, []int{1})
+	out := lazy.MapCtx(ctx, nums, func(ctx context.Context, v int) (int, error) {
+		<-ctx.Done()
+		released <- ctx.Err()
+		return 0, ctx.Err()
+	}, lazy.WithTimeout(10*time.Millisecond))
+
+	got, err := lazy.Collect(out)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected the item to be dropped, got %v", got)
+	}
+	select {
+	case err := <-released:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("mapper context ended with %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("mapper context was not cancelled on timeout")
+	}
+}
@@ -0,0 +1,75 @@
+package lazy
+
+import "context"
+
+// MapSafe is Map for mappers that may panic: a panic is recovered and handed
+// to onPanic, which may supply a fallback output.
+//
+// Input: object[IN], mapper(IN) (OUT, error), onPanic(v IN, recovered any) (OUT, bool)
+// Output: object[OUT]
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: mapper errors handled via WithErrHandler → DecisionStop | DecisionIgnore;
+// panics never reach the handler
+// Buffering: output channel capacity via WithSize
+//
+// When onPanic returns true its output is emitted in place of the value;
+// when it returns false the value is dropped and the stage continues.
+func MapSafe[IN any, OUT any](ctx context.Context, obj object[IN], mapper func(v IN) (OUT, error), onPanic func(v IN, recovered any) (OUT, bool), opts ...optionFunc) object[OUT] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan OUT, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for v := range obj.ch {
+			if !opt.controller.wait(ctx) {
+				return
+			}
+			result, emit, err := safeCall(v, mapper, onPanic)
+			if err != nil {
+				opt.samples.record(v, err)
+				opt.observer.OnError(err)
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				opt.observer.OnDrop()
+				continue
+			}
+			if !emit {
+				opt.observer.OnDrop()
+				continue
+			}
+			opt.handleSuccess()
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- result:
+			}
+			opt.observer.OnEmit()
+		}
+	}()
+
+	return object[OUT]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
+
+// safeCall runs mapper on v, diverting a panic to onPanic.
+func safeCall[IN any, OUT any](v IN, mapper func(v IN) (OUT, error), onPanic func(v IN, recovered any) (OUT, bool)) (out OUT, emit bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			out, emit = onPanic(v, r)
+			err = nil
+		}
+	}()
+	out, err = mapper(v)
+	return out, true, err
+}
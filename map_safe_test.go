@@ -0,0 +1,62 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestMapSafe_FallbackOnPanic(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var recovered []any
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.MapSafe(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			panic("bad value")
+		}
+		return v * 10, nil
+	}, func(v int, r any) (int, bool) {
+		recovered = append(recovered, r)
+		return -v, true
+	})
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{10, -2, 30}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if want := []any{"bad value"}; !reflect.DeepEqual(recovered, want) {
+		t.Fatalf("unexpected recovered values %v", recovered)
+	}
+}
+
+func TestMapSafe_DropOnPanic(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.MapSafe(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			var m map[string]int
+			m["boom"] = v
+		}
+		return v, nil
+	}, func(int, any) (int, bool) { return 0, false })
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -53,11 +53,12 @@ func TestMap_StopOnError(t *testing.T) {
 	}))
 
 	var got []int
+	var stageErr *lazy.StageError
 	if err := lazy.Consume(mapped, func(v int) error {
 		got = append(got, v)
 		return nil
-	}); err != nil {
-		t.Fatalf("consume error: %v", err)
+	}); !errors.As(err, &stageErr) || stageErr.Stage != 1 {
+		t.Fatalf("expected stage 1 error, got %v", err)
 	}
 
 	// Should only get values before the error (1, 2)
@@ -87,6 +88,46 @@ func TestConsume_PropagatesConsumerError(t *testing.T) {
 	}
 }
 
+func TestConsume_ReturnsUpstreamStageError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+	doubled := lazy.Map(ctx, mapped, func(v int) (int, error) { return v * 2, nil })
+
+	err := lazy.Consume(doubled, func(v int) error { return nil })
+	var stageErr *lazy.StageError
+	if !errors.As(err, &stageErr) || stageErr.Stage != 1 || !errors.Is(err, boom) {
+		t.Fatalf("expected stage 1 error wrapping %v, got %v", boom, err)
+	}
+}
+
+func TestConsume_NilOnCleanCompletion(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			return 0, errors.New("ignored")
+		}
+		return v, nil
+	})
+
+	if err := lazy.Consume(mapped, func(v int) error { return nil }); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
+
 func TestOrderPreserved_WithIgnoredErrors(t *testing.T) {
 	defer goleak.VerifyNone(t)
 	ctx, cancel := context.WithCancel(context.Background())
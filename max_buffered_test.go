@@ -0,0 +1,50 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithMaxBufferedItems_DistinctStops(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 1, 3, 4, 5})
+	distinct := lazy.Distinct(ctx, nums, lazy.WithMaxBufferedItems(2))
+
+	var got []int
+	err := lazy.ConsumeE(distinct, func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+	if !errors.Is(err, lazy.ErrBufferExceeded) {
+		t.Fatalf("expected ErrBufferExceeded, got %v", err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestWithMaxBufferedItems_IgnoreDropsOverflow(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 2, 1})
+	distinct := lazy.DistinctFunc(ctx, nums, func(a, b int) bool { return a == b },
+		lazy.WithMaxBufferedItems(2), lazy.WithErrHandler(lazy.IgnoreErrorHandler))
+
+	got, err := lazy.Collect(distinct)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
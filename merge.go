@@ -0,0 +1,55 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+// Merge fans in several streams into one.
+//
+// Input: objs ...object[T]
+// Output: object[T] (every value of every input)
+// Order: preserved within each input; interleaving across inputs is
+// non-deterministic
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: unbuffered output (objs is variadic, so there are no options)
+//
+// The output closes once every input is drained. Merging zero objects yields
+// an already-closed stream.
+func Merge[T any](ctx context.Context, objs ...object[T]) object[T] {
+	stage := 0
+	errs := newErrCell()
+	for _, obj := range objs {
+		stage = max(stage, obj.stage)
+		errs.link(obj.errs)
+	}
+	stage++
+	ch := make(chan T)
+
+	var wg sync.WaitGroup
+	for _, obj := range objs {
+		wg.Add(1)
+		go func() {
+			defer recover()
+			defer wg.Done()
+			for v := range obj.ch {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- v:
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
@@ -0,0 +1,47 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestMerge_ThreeSlices(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	merged := lazy.Merge(ctx,
+		lazy.NewSlice(ctx, []int{1, 2, 3}),
+		lazy.NewSlice(ctx, []int{10, 20}),
+		lazy.NewSlice(ctx, []int{100}),
+	)
+
+	got, err := lazy.Collect(merged)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	slices.Sort(got)
+	want := []int{1, 2, 3, 10, 20, 100}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestMerge_NoInputs(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.Merge[int](ctx))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no values, got %v", got)
+	}
+}
@@ -0,0 +1,51 @@
+package lazy
+
+import "context"
+
+// MovingAverage emits the trailing average of the last window values.
+//
+// Input: object[T] of numbers, window (values <= 0 are treated as 1)
+// Output: object[float64] (one average per input value)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// Until window values have arrived, the average covers the values seen so
+// far. A ring buffer and running sum keep each update O(1).
+func MovingAverage[T Number](ctx context.Context, obj object[T], window int, opts ...optionFunc) object[float64] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan float64, opt.bufferSize(stage))
+	if window <= 0 {
+		window = 1
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		ring := make([]float64, window)
+		sum := 0.0
+		n := 0
+		for v := range obj.ch {
+			i := n % window
+			sum += float64(v) - ring[i]
+			ring[i] = float64(v)
+			n++
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- sum / float64(min(n, window)):
+			}
+		}
+	}()
+
+	return object[float64]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
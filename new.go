@@ -1,9 +1,37 @@
 package lazy
 
-import "context"
+import (
+	"context"
+	"sync"
+)
 
 type object[T any] struct {
-	ch chan T
+	ch   chan T
+	stop func()
+
+	// stage is the zero-based position in the chain: sources are 0 and each
+	// operator is one more than its (deepest) input.
+	stage int
+
+	// errs records the error that stopped a stage; single-input operators
+	// share their input's cell so a terminal can see why the stream ended.
+	errs *errCell
+}
+
+// Stop asks a source created by NewSlice or New to stop producing and close
+// its output, without needing a cancelable context. It is safe to call more
+// than once and is a no-op on objects returned by other operators.
+func (o object[T]) Stop() {
+	if o.stop != nil {
+		o.stop()
+	}
+}
+
+// newStopper returns a done channel and an idempotent func closing it.
+func newStopper() (<-chan struct{}, func()) {
+	done := make(chan struct{})
+	var once sync.Once
+	return done, func() { once.Do(func() { close(done) }) }
 }
 
 // NewSlice creates a source object from a slice.
@@ -11,25 +39,39 @@ type object[T any] struct {
 // Input: slice []T
 // Output: object[T]
 // Order: preserves input order for emitted values
-// Cancellation: stops emission when ctx.Done()
+// Cancellation: stops emission when ctx.Done() or Stop() is called
 // Errors: none
 // Buffering: output channel capacity via WithSize
 func NewSlice[T any](ctx context.Context, slice []T, opts ...optionFunc) object[T] {
 	opt := buildOpts(opts)
-	ch := make(chan T, opt.size)
+	ch := make(chan T, opt.bufferSize(0))
+	done, stop := newStopper()
+	errs := newErrCell()
+	opt.group.add(errs)
 	go func() {
+		defer opt.group.done()
 		defer recover()
 		defer close(ch)
+		arrivals := newArrivals(&opt)
+		defer arrivals.close()
 		for _, v := range slice {
+			arrivals.arrived()
+			if !opt.controller.wait(ctx) {
+				return
+			}
 			select {
 			case <-ctx.Done():
 				return
+			case <-done:
+				return
 			case ch <- v:
 			}
 		}
 	}()
 	return object[T]{
-		ch: ch,
+		ch:   ch,
+		stop: stop,
+		errs: errs,
 	}
 }
 
@@ -38,24 +80,38 @@ func NewSlice[T any](ctx context.Context, slice []T, opts ...optionFunc) object[
 // Input: in <-chan T (receive-only, user-provided)
 // Output: object[T] (forwards values from in)
 // Order: preserves input order for emitted values
-// Cancellation: stops forwarding when ctx.Done()
+// Cancellation: stops forwarding when ctx.Done() or Stop() is called
 // Errors: none
 // Buffering: output channel capacity via WithSize
 func New[T any](ctx context.Context, in <-chan T, opts ...optionFunc) object[T] {
 	opt := buildOpts(opts)
-	ch := make(chan T, opt.size)
+	ch := make(chan T, opt.bufferSize(0))
+	done, stop := newStopper()
+	errs := newErrCell()
+	opt.group.add(errs)
 	go func() {
+		defer opt.group.done()
 		defer recover()
 		defer close(ch)
+		arrivals := newArrivals(&opt)
+		defer arrivals.close()
 		for v := range in {
+			arrivals.arrived()
+			if !opt.controller.wait(ctx) {
+				return
+			}
 			select {
 			case <-ctx.Done():
 				return
+			case <-done:
+				return
 			case ch <- v:
 			}
 		}
 	}()
 	return object[T]{
-		ch: ch,
+		ch:   ch,
+		stop: stop,
+		errs: errs,
 	}
 }
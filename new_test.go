@@ -38,3 +38,30 @@ func TestNew_WrapsUserChannel(t *testing.T) {
 		t.Fatalf("unexpected result. got=%v want=%v", got, want)
 	}
 }
+
+func TestNewSlice_StopWithoutContext(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	var big []int
+	for i := 0; i < 100000; i++ {
+		big = append(big, i)
+	}
+
+	nums := lazy.NewSlice(context.Background(), big)
+
+	count := 0
+	if err := lazy.Consume(nums, func(v int) error {
+		count++
+		if count == 5 {
+			nums.Stop()
+			nums.Stop() // idempotent
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	if count < 5 || count == len(big) {
+		t.Fatalf("expected production to stop shortly after 5 items, got %d", count)
+	}
+}
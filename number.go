@@ -0,0 +1,8 @@
+package lazy
+
+// Number is the constraint for numeric operators such as MovingAverage.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
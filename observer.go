@@ -0,0 +1,20 @@
+package lazy
+
+// Observer receives per-value events from a stage, e.g. to drive metrics
+// counters. Methods are called from the stage goroutine, one at a time.
+// Attach one with WithObserver; Map and Filter report to it.
+type Observer interface {
+	// OnEmit is called after a value was sent downstream.
+	OnEmit()
+	// OnError is called for every error returned by the user function.
+	OnError(err error)
+	// OnDrop is called when a failed value is dropped under DecisionIgnore.
+	OnDrop()
+}
+
+// noopObserver is the default Observer.
+type noopObserver struct{}
+
+func (noopObserver) OnEmit()       {}
+func (noopObserver) OnError(error) {}
+func (noopObserver) OnDrop()       {}
@@ -0,0 +1,67 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+type countingObserver struct {
+	emits, errors, drops int
+}
+
+func (c *countingObserver) OnEmit()       { c.emits++ }
+func (c *countingObserver) OnError(error) { c.errors++ }
+func (c *countingObserver) OnDrop()       { c.drops++ }
+
+func TestWithObserver_CountsEvents(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var obs countingObserver
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v%3 == 0 {
+			return 0, errors.New("multiple of three")
+		}
+		return v, nil
+	}, lazy.WithObserver(&obs))
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 5 {
+		t.Fatalf("expected 5 values, got %v", got)
+	}
+	if obs.emits != 5 || obs.errors != 2 || obs.drops != 2 {
+		t.Fatalf("unexpected tallies %+v", obs)
+	}
+}
+
+func TestWithObserver_StopIsNotADrop(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var obs countingObserver
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	kept := lazy.Filter(ctx, nums, func(v int) (bool, error) {
+		if v == 2 {
+			return false, errors.New("boom")
+		}
+		return true, nil
+	}, lazy.WithObserver(&obs), lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var stageErr *lazy.StageError
+	if _, err := lazy.Collect(kept); !errors.As(err, &stageErr) || stageErr.Stage != 1 {
+		t.Fatalf("expected stage 1 error, got %v", err)
+	}
+	if obs.emits != 1 || obs.errors != 1 || obs.drops != 0 {
+		t.Fatalf("unexpected tallies %+v", obs)
+	}
+}
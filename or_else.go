@@ -0,0 +1,67 @@
+package lazy
+
+import "context"
+
+// OrElse forwards primary, or falls back to secondary if primary is empty.
+//
+// Input: primary object[T], secondary func() object[T] (called lazily)
+// Output: object[T] (primary values, or secondary values when primary had none)
+// Order: preserves input order for emitted values
+// Cancellation: guards receives of the first value and all sends with ctx.Done()
+// Errors: if primary closed because a stage hit DecisionStop, that error is
+// kept and secondary is not called
+// Buffering: output channel capacity via WithSize
+//
+// secondary is only invoked once primary has closed cleanly without emitting
+// anything, so its source is never started when it is not needed. A primary
+// that aborted before its first value is a failure, not an empty result.
+func OrElse[T any](ctx context.Context, primary object[T], secondary func() object[T], opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := primary.stage + 1
+	errs := newErrCell(primary.errs)
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+
+		src := primary.ch
+		var first T
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return
+		case first, ok = <-src:
+		}
+		if ok {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- first:
+			}
+		} else {
+			if primary.errs.get() != nil {
+				return
+			}
+			fallback := secondary()
+			errs.link(fallback.errs)
+			src = fallback.ch
+		}
+
+		for v := range src {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
@@ -0,0 +1,88 @@
+package lazy
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/goleak"
+)
+
+// Whitebox: the secondary factory returns object[T], which package lazy_test
+// cannot name.
+func TestOrElse_EmptyPrimaryUsesSecondary(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	primary := NewSlice(ctx, []int{})
+	out := OrElse(ctx, primary, func() object[int] {
+		return NewSlice(ctx, []int{7, 8, 9})
+	})
+
+	var got []int
+	if err := Consume(out, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []int{7, 8, 9}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestOrElse_NonEmptyPrimarySkipsSecondary(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	called := false
+	primary := NewSlice(ctx, []int{1, 2})
+	out := OrElse(ctx, primary, func() object[int] {
+		called = true
+		return NewSlice(ctx, []int{7, 8, 9})
+	})
+
+	var got []int
+	if err := Consume(out, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []int{1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if called {
+		t.Fatal("secondary should not be constructed when primary has values")
+	}
+}
+
+func TestOrElse_FailingPrimarySkipsSecondary(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	primary := Map(ctx, NewSlice(ctx, []int{1, 2, 3}), func(v int) (int, error) {
+		return 0, boom
+	}, WithErrHandler(func(err error) Decision { return DecisionStop }))
+	out := OrElse(ctx, primary, func() object[int] {
+		t.Error("secondary must not be called after primary failed")
+		return NewSlice(ctx, []int{7})
+	})
+
+	got, err := Collect(out)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected primary error, got %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no values, got %v", got)
+	}
+}
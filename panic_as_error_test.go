@@ -0,0 +1,66 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithPanicAsError_HandlerSeesPanic(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var seen []error
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			panic("bad value")
+		}
+		return v, nil
+	}, lazy.WithPanicAsError(), lazy.WithErrHandler(func(err error) lazy.Decision {
+		seen = append(seen, err)
+		return lazy.DecisionIgnore
+	}))
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if len(seen) != 1 || !errors.Is(seen[0], lazy.ErrPanic) || seen[0].Error() != "panic: bad value" {
+		t.Fatalf("unexpected handler errors %v", seen)
+	}
+}
+
+func TestWithPanicAsError_FilterStops(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	kept := lazy.Filter(ctx, nums, func(v int) (bool, error) {
+		if v == 2 {
+			panic(errors.New("nil map"))
+		}
+		return true, nil
+	}, lazy.WithPanicAsError(), lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var got []int
+	err := lazy.ConsumeE(kept, func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+	if !errors.Is(err, lazy.ErrPanic) {
+		t.Fatalf("expected ErrPanic, got %v", err)
+	}
+	if want := []int{1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,87 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelMap transforms values with mapper on a pool of workers.
+//
+// Input: object[IN], workers (values < 1 are treated as 1), mapper(IN) (OUT, error)
+// Output: object[OUT]
+// Order: NOT preserved; results are emitted as workers finish them
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore;
+// Stop cancels the remaining workers, so the output closes without waiting
+// for upstream
+// Buffering: output channel capacity via WithSize
+//
+// The error handler is never called concurrently. The output closes once
+// every worker has exited. Use ParallelMapOrdered when order matters.
+func ParallelMap[IN any, OUT any](ctx context.Context, obj object[IN], workers int, mapper func(v IN) (OUT, error), opts ...optionFunc) object[OUT] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan OUT, opt.bufferSize(stage))
+	if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var mu sync.Mutex // serializes opt's error policy state
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer recover()
+			defer wg.Done()
+			for {
+				var v IN
+				var ok bool
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok = <-obj.ch:
+				}
+				if !ok {
+					return
+				}
+				result, err := mapper(cloneInput(&opt, v))
+				if err != nil {
+					mu.Lock()
+					decision := opt.handleError(err)
+					mu.Unlock()
+					if decision == DecisionStop {
+						obj.errs.set(stage, err)
+						cancel()
+						return
+					}
+					// DecisionIgnore: drop value and continue
+					continue
+				}
+				mu.Lock()
+				opt.handleSuccess()
+				mu.Unlock()
+
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- result:
+				}
+			}
+		}()
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		wg.Wait()
+		cancel()
+		close(ch)
+	}()
+
+	return object[OUT]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
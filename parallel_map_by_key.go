@@ -0,0 +1,122 @@
+package lazy
+
+import (
+	"context"
+	"hash/maphash"
+	"sync"
+)
+
+// ParallelMapByKey transforms values on a pool of workers, sending every value
+// with the same key to the same worker.
+//
+// Input: object[IN], workers (values < 1 are treated as 1), key(IN) K,
+// mapper(IN) (OUT, error)
+// Output: object[OUT]
+// Order: preserved among values sharing a key; NOT preserved across keys
+// Cancellation: guards receives, dispatch and sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore;
+// Stop cancels the remaining workers, so the output closes without waiting
+// for upstream
+// Buffering: output channel capacity via WithSize; each worker's inbox holds
+// one value
+//
+// Keys are hashed to a worker index, so a mapper never sees one key from two
+// goroutines and per-key state needs no locking. A slow key delays the other
+// keys sharing its worker, and dispatch blocks while that worker's inbox is
+// full. The error handler is never called concurrently.
+func ParallelMapByKey[IN any, OUT any, K comparable](ctx context.Context, obj object[IN], workers int, key func(v IN) K, mapper func(v IN) (OUT, error), opts ...optionFunc) object[OUT] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan OUT, opt.bufferSize(stage))
+	if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	inboxes := make([]chan IN, workers)
+	for i := range inboxes {
+		inboxes[i] = make(chan IN, 1)
+	}
+
+	// Dispatcher: route each value to its key's worker.
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer func() {
+			for _, inbox := range inboxes {
+				close(inbox)
+			}
+		}()
+		seed := maphash.MakeSeed()
+		for {
+			var v IN
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-obj.ch:
+			}
+			if !ok {
+				return
+			}
+			i := maphash.Comparable(seed, key(v)) % uint64(workers)
+			select {
+			case <-ctx.Done():
+				return
+			case inboxes[i] <- v:
+			}
+		}
+	}()
+
+	var mu sync.Mutex // serializes opt's error policy state
+	var wg sync.WaitGroup
+	for _, inbox := range inboxes {
+		wg.Add(1)
+		go func() {
+			defer recover()
+			defer wg.Done()
+			for v := range inbox {
+				if ctx.Err() != nil {
+					return
+				}
+				result, err := mapper(v)
+				if err != nil {
+					mu.Lock()
+					decision := opt.handleError(err)
+					mu.Unlock()
+					if decision == DecisionStop {
+						obj.errs.set(stage, err)
+						cancel()
+						return
+					}
+					// DecisionIgnore: drop value and continue
+					continue
+				}
+				mu.Lock()
+				opt.handleSuccess()
+				mu.Unlock()
+
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- result:
+				}
+			}
+		}()
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		wg.Wait()
+		cancel()
+		close(ch)
+	}()
+
+	return object[OUT]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,93 @@
+package lazy_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// goroutineID parses the current goroutine's id from its stack header; it
+// stands in for a worker id the mapper cannot otherwise observe.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	id, _ := strconv.ParseUint(string(buf[:bytes.IndexByte(buf, ' ')]), 10, 64)
+	return id
+}
+
+func TestParallelMapByKey_KeyAffinity(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var in []int
+	for i := range 1000 {
+		in = append(in, i)
+	}
+
+	var mu sync.Mutex
+	workersByKey := map[int]map[uint64]struct{}{}
+	lastByKey := map[int]int{}
+	inOrder := true
+	nums := lazy.NewSlice(ctx, in)
+	out := lazy.ParallelMapByKey(ctx, nums, 4, func(v int) int { return v % 10 }, func(v int) (int, error) {
+		k := v % 10
+		mu.Lock()
+		defer mu.Unlock()
+		if workersByKey[k] == nil {
+			workersByKey[k] = map[uint64]struct{}{}
+		}
+		workersByKey[k][goroutineID()] = struct{}{}
+		if last, ok := lastByKey[k]; ok && last > v {
+			inOrder = false
+		}
+		lastByKey[k] = v
+		return v, nil
+	})
+
+	got, err := lazy.Collect(out)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != len(in) {
+		t.Fatalf("expected %d results, got %d", len(in), len(got))
+	}
+	if len(workersByKey) != 10 {
+		t.Fatalf("expected 10 keys, got %d", len(workersByKey))
+	}
+	for k, ws := range workersByKey {
+		if len(ws) != 1 {
+			t.Fatalf("key %d was processed by %d workers", k, len(ws))
+		}
+	}
+	if !inOrder {
+		t.Fatal("values of one key were mapped out of input order")
+	}
+}
+
+func TestParallelMapByKey_StopClosesWithIdleUpstream(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// in is never closed: the dispatcher sits idle on the receive.
+	in := make(chan int)
+	defer close(in)
+	boom := errors.New("boom")
+	mapped := lazy.ParallelMapByKey(ctx, lazy.New(ctx, in), 3, func(v int) int { return v }, func(v int) (int, error) {
+		return 0, boom
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	in <- 1
+	if _, err := lazy.Collect(mapped); !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+}
@@ -0,0 +1,150 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelMapOrdered transforms values with mapper on a pool of workers and
+// emits results in input order.
+//
+// Input: object[IN], workers (values < 1 are treated as 1), mapper(IN) (OUT, error)
+// Output: object[OUT]
+// Order: preserves input order for emitted values
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore, in input
+// order; Stop cancels the remaining workers, so the output closes without
+// waiting for upstream
+// Buffering: output channel capacity via WithSize
+//
+// Values are tagged with a sequence number and reassembled in a reorder
+// buffer. At most 2*workers values are in flight, so a slow item holds back
+// up to that many completed results behind it and then stalls intake until
+// it finishes. The error handler is never called concurrently.
+func ParallelMapOrdered[IN any, OUT any](ctx context.Context, obj object[IN], workers int, mapper func(v IN) (OUT, error), opts ...optionFunc) object[OUT] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan OUT, opt.bufferSize(stage))
+	if workers < 1 {
+		workers = 1
+	}
+
+	type job struct {
+		seq int
+		v   IN
+	}
+	type result struct {
+		seq int
+		out OUT
+		err error
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	jobs := make(chan job)
+	results := make(chan result, workers)
+	window := make(chan struct{}, 2*workers)
+
+	// Dispatcher: tag values with sequence numbers, bounded by window.
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(jobs)
+		seq := 0
+		for {
+			var v IN
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-obj.ch:
+			}
+			if !ok {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case window <- struct{}{}:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- job{seq: seq, v: cloneInput(&opt, v)}:
+			}
+			seq++
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer recover()
+			defer wg.Done()
+			for j := range jobs {
+				out, err := mapper(j.v)
+				select {
+				case <-ctx.Done():
+					return
+				case results <- result{seq: j.seq, out: out, err: err}:
+				}
+			}
+		}()
+	}
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		wg.Wait()
+		close(results)
+	}()
+
+	// Collector: emit in sequence order; on exit wait for workers to finish.
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		defer func() {
+			cancel()
+			for range results {
+			}
+		}()
+		pending := make(map[int]result)
+		next := 0
+		for r := range results {
+			pending[r.seq] = r
+			for {
+				p, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				<-window
+
+				if p.err != nil {
+					if decision := opt.handleError(p.err); decision == DecisionStop {
+						obj.errs.set(stage, p.err)
+						return
+					}
+					// DecisionIgnore: drop value and continue
+					continue
+				}
+				opt.handleSuccess()
+
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- p.out:
+				}
+			}
+		}
+	}()
+
+	return object[OUT]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,84 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestParallelMapOrdered_MatchesMap(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var in []int
+	for i := 0; i < 40; i++ {
+		in = append(in, i)
+	}
+	// Earlier items sleep longer, so unordered completion would reverse them.
+	slowFirst := func(v int) (int, error) {
+		time.Sleep(time.Duration(40-v) * 100 * time.Microsecond)
+		return v * 3, nil
+	}
+
+	want, err := lazy.Collect(lazy.Map(ctx, lazy.NewSlice(ctx, in), slowFirst))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	got, err := lazy.Collect(lazy.ParallelMapOrdered(ctx, lazy.NewSlice(ctx, in), 4, slowFirst))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestParallelMapOrdered_StopOnError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6})
+	mapped := lazy.ParallelMapOrdered(ctx, nums, 3, func(v int) (int, error) {
+		if v == 4 {
+			return 0, errors.New("boom")
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	got, err := lazy.Collect(mapped)
+	var stageErr *lazy.StageError
+	if !errors.As(err, &stageErr) || stageErr.Stage != 1 {
+		t.Fatalf("expected stage 1 error, got %v", err)
+	}
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestParallelMapOrdered_StopClosesWithIdleUpstream(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// in is never closed: the dispatcher sits idle on the receive.
+	in := make(chan int)
+	defer close(in)
+	boom := errors.New("boom")
+	mapped := lazy.ParallelMapOrdered(ctx, lazy.New(ctx, in), 3, func(v int) (int, error) {
+		return 0, boom
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	in <- 1
+	if _, err := lazy.Collect(mapped); !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+}
@@ -0,0 +1,115 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestParallelMap_AllResults(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const n = 5000
+	var in []int
+	for i := 0; i < n; i++ {
+		in = append(in, i)
+	}
+
+	nums := lazy.NewSlice(ctx, in)
+	squared := lazy.ParallelMap(ctx, nums, 4, func(v int) (int, error) {
+		return v * v, nil
+	})
+
+	got, err := lazy.Collect(squared)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != n {
+		t.Fatalf("expected %d results, got %d", n, len(got))
+	}
+	slices.Sort(got)
+	for i, v := range got {
+		if v != i*i {
+			t.Fatalf("missing or wrong result at %d: %d", i, v)
+		}
+	}
+}
+
+func TestParallelMap_DefaultIgnoreError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6})
+	odds := lazy.ParallelMap(ctx, nums, 3, func(v int) (int, error) {
+		if v%2 == 0 {
+			return 0, errors.New("even")
+		}
+		return v, nil
+	})
+
+	got, err := lazy.Collect(odds)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	slices.Sort(got)
+	if want := []int{1, 3, 5}; !slices.Equal(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestParallelMap_StopOnErrorCancelsWorkers(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const n = 100000
+	var in []int
+	for i := 0; i < n; i++ {
+		in = append(in, i)
+	}
+
+	var calls atomic.Int64
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, in)
+	mapped := lazy.ParallelMap(ctx, nums, 4, func(v int) (int, error) {
+		calls.Add(1)
+		if v == 100 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	if err := lazy.ConsumeE(mapped, func(v int) error { return nil }); !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if calls.Load() == n {
+		t.Fatal("expected remaining work to be cancelled after stop")
+	}
+}
+
+func TestParallelMap_StopClosesWithIdleUpstream(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// in is never closed: the other workers sit idle on the receive.
+	in := make(chan int)
+	defer close(in)
+	boom := errors.New("boom")
+	mapped := lazy.ParallelMap(ctx, lazy.New(ctx, in), 3, func(v int) (int, error) {
+		return 0, boom
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	in <- 1
+	if _, err := lazy.Collect(mapped); !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+}
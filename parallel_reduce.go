@@ -0,0 +1,61 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelReduce drains the object on a pool of workers, each folding its
+// share of the values, and then combines the partial results.
+//
+// Input: ctx, object[T], workers (values < 1 are treated as 1), identity T,
+// combine(a, b T) T
+// Output: (T, error) the combined result
+// Order: unspecified; values are split across workers as they arrive
+// Cancellation: guards receives with select on ctx.Done()
+// Errors: ctx.Err() if ctx is cancelled before the stream closes, together
+// with the partial result; if the stream closed because a stage hit
+// DecisionStop, that stage's error
+// Buffering: N/A
+//
+// combine must be associative and commutative, and identity must be its
+// neutral element (0 for +, 1 for *), because the grouping and order of
+// combinations vary from run to run. Parallelism only pays off when combine
+// is costly compared with a channel receive.
+func ParallelReduce[T any](ctx context.Context, obj object[T], workers int, identity T, combine func(a, b T) T) (T, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	partials := make([]T, workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			acc := identity
+			defer func() { partials[i] = acc }()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-obj.ch:
+					if !ok {
+						return
+					}
+					acc = combine(acc, v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := identity
+	for _, p := range partials {
+		result = combine(result, p)
+	}
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+	return result, obj.errs.get()
+}
@@ -0,0 +1,51 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestParallelReduce_MatchesSequentialSum(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const n = 100000
+	in := make([]int, n)
+	want := 0
+	for i := range in {
+		in[i] = i
+		want += i
+	}
+
+	got, err := lazy.ParallelReduce(ctx, lazy.NewSlice(ctx, in, lazy.WithSize(64)), 4, 0, func(a, b int) int { return a + b })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != want {
+		t.Fatalf("parallel sum %d, want %d", got, want)
+	}
+}
+
+func TestParallelReduce_Cancelled(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.New(ctx, naturals(ctx))
+	count := 0
+	_, err := lazy.ParallelReduce(ctx, nums, 1, 0, func(a, b int) int {
+		count++
+		if count == 10 {
+			cancel()
+		}
+		return a + b
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
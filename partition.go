@@ -0,0 +1,56 @@
+package lazy
+
+import "context"
+
+// Partition splits one stream into the values matching pred and the rest.
+//
+// Input: object[T], pred(T) (bool, error)
+// Output: (object[T], object[T]) matched values, then unmatched values
+// Order: preserves input order on both outputs
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore; Ignore
+// drops the value from both outputs
+// Buffering: each output channel's capacity via WithSize
+//
+// Both outputs close when the input closes. Values are routed one at a time,
+// so a branch that is not read blocks the other once its buffer is full.
+// Consume both branches concurrently.
+func Partition[T any](ctx context.Context, obj object[T], pred func(v T) (bool, error), opts ...optionFunc) (object[T], object[T]) {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	matched := make(chan T, opt.bufferSize(stage))
+	unmatched := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(matched)
+		defer close(unmatched)
+		for v := range obj.ch {
+			ok, err := pred(v)
+			if err != nil {
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			opt.handleSuccess()
+
+			out := unmatched
+			if ok {
+				out = matched
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case out <- v:
+			}
+		}
+	}()
+
+	return object[T]{ch: matched, stage: stage, errs: obj.errs},
+		object[T]{ch: unmatched, stage: stage, errs: obj.errs}
+}
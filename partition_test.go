@@ -0,0 +1,61 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestPartition_EvensAndOdds(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6})
+	evens, odds := lazy.Partition(ctx, nums, func(v int) (bool, error) { return v%2 == 0, nil })
+
+	var gotEvens, gotOdds []int
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		gotEvens, _ = lazy.Collect(evens)
+	}()
+	go func() {
+		defer wg.Done()
+		gotOdds, _ = lazy.Collect(odds)
+	}()
+	wg.Wait()
+
+	if want := []int{2, 4, 6}; !reflect.DeepEqual(gotEvens, want) {
+		t.Fatalf("unexpected evens. got=%v want=%v", gotEvens, want)
+	}
+	if want := []int{1, 3, 5}; !reflect.DeepEqual(gotOdds, want) {
+		t.Fatalf("unexpected odds. got=%v want=%v", gotOdds, want)
+	}
+}
+
+func TestPartition_IgnoreDropsFromBoth(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	big, small := lazy.Partition(ctx, nums, func(v int) (bool, error) {
+		if v == 2 {
+			return false, errors.New("skip")
+		}
+		return v > 2, nil
+	}, lazy.WithSize(3))
+
+	gotSmall, _ := lazy.Collect(small)
+	gotBig, _ := lazy.Collect(big)
+	if !reflect.DeepEqual(gotBig, []int{3}) || !reflect.DeepEqual(gotSmall, []int{1}) {
+		t.Fatalf("unexpected result. big=%v small=%v", gotBig, gotSmall)
+	}
+}
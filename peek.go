@@ -0,0 +1,42 @@
+package lazy
+
+import "context"
+
+// Peek forwards values unchanged, calling fn on each for side effects such as
+// logging or metrics.
+//
+// Input: object[T], fn(T)
+// Output: object[T] (the input values)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// fn runs before the value is sent downstream.
+func Peek[T any](ctx context.Context, obj object[T], fn func(v T), opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for v := range obj.ch {
+			fn(v)
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
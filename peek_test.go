@@ -0,0 +1,32 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestPeek_ForwardsUnchanged(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var seen []int
+	nums := lazy.NewSlice(ctx, []int{3, 1, 4, 1, 5})
+	peeked := lazy.Peek(ctx, nums, func(v int) { seen = append(seen, v) }, lazy.WithSize(2))
+
+	got, err := lazy.Collect(peeked)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []int{3, 1, 4, 1, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if !reflect.DeepEqual(seen, want) {
+		t.Fatalf("fn saw %v, want %v", seen, want)
+	}
+}
@@ -0,0 +1,25 @@
+package lazy
+
+// Pivot drains the object into a table keyed by row and column.
+//
+// Input: object[T], rowKey(T) R, colKey(T) C, agg(acc int, v T) int
+// Output: (map[R]map[C]int, error); each cell starts at 0 and is folded with agg
+// Order: N/A; values are folded in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: retains one int per distinct (row, column) pair
+func Pivot[T any, R, C comparable](obj object[T], rowKey func(v T) R, colKey func(v T) C, agg func(acc int, v T) int) (map[R]map[C]int, error) {
+	table := make(map[R]map[C]int)
+	for v := range obj.ch {
+		r := rowKey(v)
+		row, ok := table[r]
+		if !ok {
+			row = make(map[C]int)
+			table[r] = row
+		}
+		c := colKey(v)
+		row[c] = agg(row[c], v)
+	}
+	return table, obj.errs.get()
+}
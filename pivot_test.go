@@ -0,0 +1,46 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+type sale struct {
+	Region  string
+	Product string
+	Units   int
+}
+
+func TestPivot_SalesByRegionAndProduct(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sales := lazy.NewSlice(ctx, []sale{
+		{"eu", "apple", 3},
+		{"us", "apple", 1},
+		{"eu", "pear", 2},
+		{"eu", "apple", 4},
+	})
+
+	got, err := lazy.Pivot(sales,
+		func(s sale) string { return s.Region },
+		func(s sale) string { return s.Product },
+		func(acc int, s sale) int { return acc + s.Units },
+	)
+	if err != nil {
+		t.Fatalf("pivot error: %v", err)
+	}
+
+	want := map[string]map[string]int{
+		"eu": {"apple": 7, "pear": 2},
+		"us": {"apple": 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,62 @@
+package lazy
+
+import "context"
+
+// Process lets fn push any number of outputs per input through emit.
+//
+// Input: object[IN], fn(IN, emit func(OUT) error) error
+// Output: object[OUT] (every value passed to emit)
+// Order: preserves input order; outputs of one input keep their emit order
+// Cancellation: emit guards its send with select on ctx.Done()
+// Errors: fn's error is handled via WithErrHandler → DecisionStop |
+// DecisionIgnore; values emitted before the error are kept
+// Buffering: output channel capacity via WithSize
+//
+// emit returns ctx.Err() once the stream is shutting down; fn should return
+// promptly when that happens, and the stage then exits without consulting the
+// error handler. emit must only be called while fn is running.
+func Process[IN any, OUT any](ctx context.Context, obj object[IN], fn func(v IN, emit func(OUT) error) error, opts ...optionFunc) object[OUT] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan OUT, opt.bufferSize(stage))
+
+	emit := func(v OUT) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- v:
+			return nil
+		}
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for v := range obj.ch {
+			err := fn(v, emit)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: keep what was emitted and continue
+				continue
+			}
+			opt.handleSuccess()
+		}
+	}()
+
+	return object[OUT]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,84 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestProcess_EmitsTwicePerInput(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	doubled := lazy.Process(ctx, nums, func(v int, emit func(int) error) error {
+		if err := emit(v); err != nil {
+			return err
+		}
+		return emit(v * 10)
+	})
+
+	got, err := lazy.Collect(doubled)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 10, 2, 20, 3, 30}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestProcess_StopOnError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	out := lazy.Process(ctx, nums, func(v int, emit func(int) error) error {
+		if err := emit(v); err != nil {
+			return err
+		}
+		if v == 2 {
+			return boom
+		}
+		return nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var got []int
+	err := lazy.ConsumeE(out, func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestProcess_EmitFailsAfterCancel(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	emitErr := make(chan error, 1)
+	out := lazy.Process(ctx, lazy.NewSlice(ctx, []int{1}), func(v int, emit func(int) error) error {
+		cancel()
+		err := emit(v)
+		emitErr <- err
+		return err
+	})
+
+	if _, err := lazy.Collect(out); err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if err := <-emitErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled from emit, got %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package lazy
+
+import (
+	"math"
+	"slices"
+)
+
+// Quantiles drains the object and estimates the requested quantiles.
+//
+// Input: object[T] of numbers, qs quantiles in [0, 1]
+// Output: ([]float64, error) one estimate per entry of qs; NaN for an empty stream
+// Order: N/A
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: constant memory (five markers per quantile)
+//
+// Estimates use the P² algorithm (Jain & Chlamtac), which tracks each
+// quantile with five markers adjusted by piecewise-parabolic interpolation.
+// Results are approximate: accurate for smooth distributions with many
+// values, less so for tiny, heavily skewed, or multi-modal inputs. Streams
+// with fewer than five values are answered exactly.
+func Quantiles[T Number](obj object[T], qs []float64) ([]float64, error) {
+	ests := make([]p2Estimator, len(qs))
+	for i, q := range qs {
+		ests[i].p = q
+	}
+	for v := range obj.ch {
+		for i := range ests {
+			ests[i].add(float64(v))
+		}
+	}
+	out := make([]float64, len(qs))
+	for i := range ests {
+		out[i] = ests[i].value()
+	}
+	return out, obj.errs.get()
+}
+
+// p2Estimator is a single-quantile P² estimator.
+type p2Estimator struct {
+	p     float64
+	count int
+	q     [5]float64 // marker heights
+	n     [5]float64 // actual marker positions
+	want  [5]float64 // desired marker positions
+}
+
+func (e *p2Estimator) add(x float64) {
+	if e.count < 5 {
+		e.q[e.count] = x
+		e.count++
+		if e.count == 5 {
+			slices.Sort(e.q[:])
+			p := e.p
+			e.n = [5]float64{0, 1, 2, 3, 4}
+			e.want = [5]float64{0, 2 * p, 4 * p, 2 + 2*p, 4}
+		}
+		return
+	}
+	e.count++
+
+	var k int
+	switch {
+	case x < e.q[0]:
+		e.q[0], k = x, 0
+	case x < e.q[1]:
+		k = 0
+	case x < e.q[2]:
+		k = 1
+	case x < e.q[3]:
+		k = 2
+	case x <= e.q[4]:
+		k = 3
+	default:
+		e.q[4], k = x, 3
+	}
+	for i := k + 1; i < 5; i++ {
+		e.n[i]++
+	}
+	p := e.p
+	inc := [5]float64{0, p / 2, p, (1 + p) / 2, 1}
+	for i := range e.want {
+		e.want[i] += inc[i]
+	}
+
+	for i := 1; i <= 3; i++ {
+		d := e.want[i] - e.n[i]
+		if (d >= 1 && e.n[i+1]-e.n[i] > 1) || (d <= -1 && e.n[i-1]-e.n[i] < -1) {
+			d = math.Copysign(1, d)
+			if h := e.parabolic(i, d); e.q[i-1] < h && h < e.q[i+1] {
+				e.q[i] = h
+			} else {
+				e.q[i] = e.linear(i, d)
+			}
+			e.n[i] += d
+		}
+	}
+}
+
+func (e *p2Estimator) parabolic(i int, d float64) float64 {
+	q, n := e.q, e.n
+	return q[i] + d/(n[i+1]-n[i-1])*
+		((n[i]-n[i-1]+d)*(q[i+1]-q[i])/(n[i+1]-n[i])+
+			(n[i+1]-n[i]-d)*(q[i]-q[i-1])/(n[i]-n[i-1]))
+}
+
+func (e *p2Estimator) linear(i int, d float64) float64 {
+	j := i + int(d)
+	return e.q[i] + d*(e.q[j]-e.q[i])/(e.n[j]-e.n[i])
+}
+
+func (e *p2Estimator) value() float64 {
+	if e.count == 0 {
+		return math.NaN()
+	}
+	if e.count < 5 {
+		s := slices.Clone(e.q[:e.count])
+		slices.Sort(s)
+		return s[int(math.Round(e.p*float64(len(s)-1)))]
+	}
+	return e.q[2]
+}
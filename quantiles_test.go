@@ -0,0 +1,48 @@
+package lazy_test
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestQuantiles_UniformWithinTolerance(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rng := rand.New(rand.NewSource(7))
+	in := make([]float64, 20000)
+	for i := range in {
+		in[i] = rng.Float64() * 100
+	}
+
+	qs := []float64{0.5, 0.9, 0.99}
+	got, err := lazy.Quantiles(lazy.NewSlice(ctx, in), qs)
+	if err != nil {
+		t.Fatalf("quantiles error: %v", err)
+	}
+	for i, q := range qs {
+		if want := q * 100; math.Abs(got[i]-want) > 2 {
+			t.Fatalf("q=%v: estimate %.2f not within 2 of %.2f", q, got[i], want)
+		}
+	}
+}
+
+func TestQuantiles_SmallInputExact(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Quantiles(lazy.NewSlice(ctx, []int{9, 1, 5}), []float64{0, 0.5, 1})
+	if err != nil {
+		t.Fatalf("quantiles error: %v", err)
+	}
+	if got[0] != 1 || got[1] != 5 || got[2] != 9 {
+		t.Fatalf("unexpected result %v", got)
+	}
+}
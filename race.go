@@ -0,0 +1,98 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+)
+
+// Race forwards whichever input produces a value first and abandons the rest.
+//
+// Input: objs ...object[T]
+// Output: object[T] (every value of the winning input)
+// Order: preserves the winner's order
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: none
+// Buffering: unbuffered output (objs is variadic, so there are no options)
+//
+// Inputs that close without a value drop out of the race; if all do, the
+// output closes empty. Once a winner is known, every other input is stopped
+// via Stop and drained in the background until it closes or ctx is cancelled,
+// so their producers should be tied to the same ctx. The output closes when
+// the winner does.
+func Race[T any](ctx context.Context, objs ...object[T]) object[T] {
+	stage := 0
+	errs := newErrCell()
+	for _, obj := range objs {
+		stage = max(stage, obj.stage)
+		errs.link(obj.errs)
+	}
+	stage++
+	ch := make(chan T)
+
+	var once sync.Once
+	winner := -1
+	decided := make(chan struct{})
+
+	// wg tracks the inputs still in the race and the winner's forwarding,
+	// not the background drains.
+	var wg sync.WaitGroup
+	wg.Add(len(objs))
+	for i, obj := range objs {
+		go func() {
+			defer recover()
+			running := true
+			done := func() {
+				if running {
+					running = false
+					wg.Done()
+				}
+			}
+			defer done()
+
+			var first T
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case <-decided:
+			case first, ok = <-obj.ch:
+				if !ok {
+					return
+				}
+				once.Do(func() {
+					winner = i
+					close(decided)
+				})
+			}
+			if winner != i {
+				obj.Stop()
+				done()
+				drain(ctx, obj.ch)
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- first:
+			}
+			for v := range obj.ch {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- v:
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
@@ -0,0 +1,78 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestRace_ForwardsOnlyTheFastest(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slowIn := make(chan int)
+	go func() {
+		defer close(slowIn)
+		select {
+		case <-time.After(50 * time.Millisecond):
+		case <-ctx.Done():
+			return
+		}
+		for _, v := range []int{100, 200} {
+			select {
+			case <-ctx.Done():
+				return
+			case slowIn <- v:
+			}
+		}
+	}()
+	slow := lazy.New(ctx, slowIn)
+	fast := lazy.NewSlice(ctx, []int{1, 2, 3})
+
+	got, err := lazy.Collect(lazy.Race(ctx, slow, fast))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestRace_SkipsEmptyInputs(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	empty := lazy.NewSlice(ctx, []int{})
+	late := lazy.Map(ctx, lazy.NewSlice(ctx, []int{7, 8}), func(v int) (int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return v, nil
+	})
+
+	got, err := lazy.Collect(lazy.Race(ctx, empty, late))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{7, 8}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestRace_AllEmpty(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.Race(ctx, lazy.NewSlice(ctx, []int{}), lazy.NewSlice(ctx, []int{})))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no values, got %v", got)
+	}
+}
@@ -0,0 +1,23 @@
+package lazy
+
+// Reduce drains the object, threading an accumulator through reducer.
+//
+// Input: object[IN], init ACC, reducer(acc ACC, v IN) (ACC, error)
+// Output: (ACC, error) final accumulator, first reducer error
+// Order: folds values in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: returns the first error from reducer together with the accumulator
+// as it stood before the failing value; if the stream closed because a stage
+// hit DecisionStop, that stage's error together with the accumulator so far
+// Buffering: N/A
+func Reduce[IN any, ACC any](obj object[IN], init ACC, reducer func(acc ACC, v IN) (ACC, error)) (ACC, error) {
+	acc := init
+	for v := range obj.ch {
+		next, err := reducer(acc, v)
+		if err != nil {
+			return acc, err
+		}
+		acc = next
+	}
+	return acc, obj.errs.get()
+}
@@ -0,0 +1,71 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestReduce_Sum(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4})
+	sum, err := lazy.Reduce(nums, 0, func(acc, v int) (int, error) {
+		return acc + v, nil
+	})
+	if err != nil {
+		t.Fatalf("reduce error: %v", err)
+	}
+	if sum != 10 {
+		t.Fatalf("expected 10, got %d", sum)
+	}
+}
+
+func TestReduce_ReturnsAccumulatorAtError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wantErr := errors.New("boom@3")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4})
+	sum, err := lazy.Reduce(nums, 0, func(acc, v int) (int, error) {
+		if v == 3 {
+			return -1, wantErr
+		}
+		return acc + v, nil
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	if sum != 3 {
+		t.Fatalf("expected accumulator 3 at error, got %d", sum)
+	}
+}
+
+func TestReduce_ReturnsStageError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 3 {
+			return 0, boom
+		}
+		return v, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	sum, err := lazy.Reduce(mapped, 0, func(acc, v int) (int, error) { return acc + v, nil })
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	if sum != 3 {
+		t.Fatalf("expected partial sum 3, got %d", sum)
+	}
+}
@@ -0,0 +1,41 @@
+package lazy
+
+import "context"
+
+// Replace substitutes with for every value matching match.
+//
+// Input: object[T], match(T) bool, replacement with T
+// Output: object[T] (with for matching values, the original otherwise)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+func Replace[T any](ctx context.Context, obj object[T], match func(v T) bool, with T, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for v := range obj.ch {
+			if match(v) {
+				v = with
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,28 @@
+package lazy
+
+import "math/rand"
+
+// Reservoir drains the object and returns a uniform random sample of k values.
+//
+// Input: object[T], k sample size, rng source of randomness
+// Output: ([]T, error) up to k values (all values if the stream has fewer)
+// Order: unspecified; slots are overwritten as the sample evolves
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: retains at most k values (Algorithm R)
+//
+// Passing a seeded rng makes the sample reproducible.
+func Reservoir[T any](obj object[T], k int, rng *rand.Rand) ([]T, error) {
+	sample := make([]T, 0, max(k, 0))
+	i := 0
+	for v := range obj.ch {
+		if i < k {
+			sample = append(sample, v)
+		} else if j := rng.Intn(i + 1); j < k {
+			sample[j] = v
+		}
+		i++
+	}
+	return sample, obj.errs.get()
+}
@@ -0,0 +1,60 @@
+package lazy_test
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestReservoir_SeededSample(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var in []int
+	for i := 0; i < 1000; i++ {
+		in = append(in, i)
+	}
+
+	sample := func() []int {
+		got, err := lazy.Reservoir(lazy.NewSlice(ctx, in), 5, rand.New(rand.NewSource(42)))
+		if err != nil {
+			t.Fatalf("reservoir error: %v", err)
+		}
+		return got
+	}
+
+	first, second := sample(), sample()
+	if len(first) != 5 {
+		t.Fatalf("expected 5 samples, got %d", len(first))
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("same seed should give same sample: %v vs %v", first, second)
+	}
+	seen := map[int]bool{}
+	for _, v := range first {
+		if v < 0 || v >= len(in) || seen[v] {
+			t.Fatalf("invalid sample %v", first)
+		}
+		seen[v] = true
+	}
+}
+
+func TestReservoir_ShortStreamReturnsAll(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Reservoir(lazy.NewSlice(ctx, []int{1, 2, 3}), 5, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("reservoir error: %v", err)
+	}
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,24 @@
+package lazy
+
+import "context"
+
+// retry calls fn until it succeeds or the attempts configured via WithRetry
+// are used up, waiting backoff(attempt) on the stage clock between tries.
+// It returns the last error if every attempt failed or ctx was cancelled
+// while waiting.
+func retry[R any](ctx context.Context, opt *option, fn func() (R, error)) (R, error) {
+	result, err := fn()
+	for attempt := 1; err != nil && attempt < opt.retryAttempts; attempt++ {
+		if opt.retryBackoff != nil {
+			select {
+			case <-ctx.Done():
+				return result, err
+			case <-opt.clock.After(opt.retryBackoff(attempt)):
+			}
+		} else if ctx.Err() != nil {
+			return result, err
+		}
+		result, err = fn()
+	}
+	return result, err
+}
@@ -0,0 +1,100 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithRetry_SucceedsAfterTransientFailures(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := map[int]int{}
+	var waits []time.Duration
+	nums := lazy.NewSlice(ctx, []int{1, 2})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		calls[v]++
+		if v == 2 && calls[v] <= 2 {
+			return 0, errors.New("flaky")
+		}
+		return v * 10, nil
+	}, lazy.WithRetry(3, func(attempt int) time.Duration {
+		waits = append(waits, time.Duration(attempt)*time.Millisecond)
+		return time.Duration(attempt) * time.Millisecond
+	}))
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{10, 20}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if calls[2] != 3 {
+		t.Fatalf("expected 3 attempts for 2, got %d", calls[2])
+	}
+	if want := []time.Duration{time.Millisecond, 2 * time.Millisecond}; !reflect.DeepEqual(waits, want) {
+		t.Fatalf("unexpected backoff schedule %v", waits)
+	}
+}
+
+func TestWithRetry_AlwaysFailingIsDropped(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attempts := 0
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	kept := lazy.Filter(ctx, nums, func(v int) (bool, error) {
+		if v == 2 {
+			attempts++
+			return false, errors.New("down")
+		}
+		return true, nil
+	}, lazy.WithRetry(4, nil))
+
+	got, err := lazy.Collect(kept)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if attempts != 4 {
+		t.Fatalf("expected 4 attempts, got %d", attempts)
+	}
+}
+
+func TestWithRetry_AlwaysFailingStops(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	down := errors.New("down")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			return 0, down
+		}
+		return v, nil
+	}, lazy.WithRetry(2, nil), lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var got []int
+	err := lazy.ConsumeE(mapped, func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+	if !errors.Is(err, down) {
+		t.Fatalf("expected %v, got %v", down, err)
+	}
+	if want := []int{1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,47 @@
+package lazy
+
+import "context"
+
+// Sample keeps every nth value, starting with the first.
+//
+// Input: object[T], n (values <= 1 pass everything through)
+// Output: object[T] (values 1, 1+n, 1+2n, ...)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+func Sample[T any](ctx context.Context, obj object[T], n int, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+	if n < 1 {
+		n = 1
+	}
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		i := 0
+		for v := range obj.ch {
+			keep := i%n == 0
+			i++
+			if !keep {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
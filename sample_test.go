@@ -0,0 +1,41 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestSample_EveryThird(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	got, err := lazy.Collect(lazy.Sample(ctx, nums, 3))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 4, 7, 10}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestSample_PassthroughForSmallN(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, n := range []int{1, 0, -2} {
+		got, err := lazy.Collect(lazy.Sample(ctx, lazy.NewSlice(ctx, []int{1, 2, 3}), n))
+		if err != nil {
+			t.Fatalf("collect error: %v", err)
+		}
+		if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("n=%d: unexpected result. got=%v want=%v", n, got, want)
+		}
+	}
+}
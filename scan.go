@@ -0,0 +1,53 @@
+package lazy
+
+import "context"
+
+// Scan threads an accumulator through fn and emits it after every value, like
+// Reduce but with the intermediate states.
+//
+// Input: object[IN], init ACC, fn(acc ACC, v IN) (ACC, error)
+// Output: object[ACC] (one accumulator per successfully folded value; init
+// itself is not emitted)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore; Ignore
+// skips the value and keeps the previous accumulator
+// Buffering: output channel capacity via WithSize
+func Scan[IN any, ACC any](ctx context.Context, obj object[IN], init ACC, fn func(acc ACC, v IN) (ACC, error), opts ...optionFunc) object[ACC] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan ACC, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		acc := init
+		for v := range obj.ch {
+			next, err := fn(acc, v)
+			if err != nil {
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			opt.handleSuccess()
+			acc = next
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- acc:
+			}
+		}
+	}()
+
+	return object[ACC]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,50 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestScan_RunningSum(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	sums := lazy.Scan(ctx, nums, 0, func(acc, v int) (int, error) { return acc + v, nil })
+
+	got, err := lazy.Collect(sums)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 3, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestScan_IgnoreKeepsAccumulator(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, -1, 2})
+	sums := lazy.Scan(ctx, nums, 10, func(acc, v int) (int, error) {
+		if v < 0 {
+			return 0, errors.New("negative")
+		}
+		return acc + v, nil
+	})
+
+	got, err := lazy.Collect(sums)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{11, 13}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,55 @@
+package lazy
+
+import (
+	"bufio"
+	"context"
+	"io"
+)
+
+// NewScanner emits the lines of r, one value per line.
+//
+// Input: r io.Reader (lines split by bufio.ScanLines, without line endings)
+// Output: object[string]
+// Order: preserves line order
+// Cancellation: checked between lines; stops emission when ctx.Done() or
+// Stop() is called
+// Errors: a read error from r always stops the stream and is recorded
+// Buffering: output channel capacity via WithSize
+//
+// Reading stops at EOF. A Read on r that blocks is not interrupted by ctx;
+// the goroutine exits as soon as it returns.
+func NewScanner(ctx context.Context, r io.Reader, opts ...optionFunc) object[string] {
+	opt := buildOpts(opts)
+	ch := make(chan string, opt.bufferSize(0))
+	done, stop := newStopper()
+	errs := newErrCell()
+
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		arrivals := newArrivals(&opt)
+		defer arrivals.close()
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			arrivals.arrived()
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case ch <- scanner.Text():
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			errs.set(0, err)
+		}
+	}()
+
+	return object[string]{
+		ch:   ch,
+		stop: stop,
+		errs: errs,
+	}
+}
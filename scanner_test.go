@@ -0,0 +1,98 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestNewScanner_EmitsLines(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lines := lazy.NewScanner(ctx, strings.NewReader("alpha\nbeta\r\ngamma"))
+
+	got, err := lazy.Collect(lines)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []string{"alpha", "beta", "gamma"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+// failingReader yields data once and then fails.
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	if f.data == "" {
+		return 0, f.err
+	}
+	n := copy(p, f.data)
+	f.data = f.data[n:]
+	return n, nil
+}
+
+func TestNewScanner_ReadErrorStops(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broken := errors.New("connection reset")
+	lines := lazy.NewScanner(ctx, &failingReader{data: "one\ntwo\n", err: broken},
+		lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	var got []string
+	err := lazy.ConsumeE(lines, func(v string) error {
+		got = append(got, v)
+		return nil
+	})
+	if !errors.Is(err, broken) {
+		t.Fatalf("expected %v, got %v", broken, err)
+	}
+	if want := []string{"one", "two"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestNewScanner_ReadErrorStopsWithDefaultHandler(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broken := errors.New("connection reset")
+	lines := lazy.NewScanner(ctx, &failingReader{data: "one\n", err: broken})
+
+	got, err := lazy.Collect(lines)
+	if !errors.Is(err, broken) {
+		t.Fatalf("expected %v, got %v", broken, err)
+	}
+	if want := []string{"one"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestNewScanner_TakeStopsReading(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lines := lazy.NewScanner(ctx, strings.NewReader(strings.Repeat("x\n", 1000)))
+
+	got, err := lazy.Collect(lazy.Take(ctx, lines, 2))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []string{"x", "x"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+package lazy
+
+import (
+	"context"
+	"iter"
+)
+
+// NewSeq emits the values of a range-over-func iterator.
+//
+// Input: seq iter.Seq[T] (may be infinite)
+// Output: object[T] (forwards values yielded by seq)
+// Order: preserves yield order
+// Cancellation: stops the iteration (yield returns false) when ctx.Done() or
+// Stop() is called
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// The output closes once seq returns, whether it was exhausted or stopped.
+func NewSeq[T any](ctx context.Context, seq iter.Seq[T], opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	ch := make(chan T, opt.bufferSize(0))
+	done, stop := newStopper()
+	errs := newErrCell()
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		arrivals := newArrivals(&opt)
+		defer arrivals.close()
+		for v := range seq {
+			arrivals.arrived()
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case ch <- v:
+			}
+		}
+	}()
+	return object[T]{
+		ch:   ch,
+		stop: stop,
+		errs: errs,
+	}
+}
+
+// Seq exposes the object as a range-over-func iterator.
+//
+// Input: object[T]
+// Output: iter.Seq[T] (yields every value of the object)
+// Order: preserves upstream order
+// Cancellation: N/A; respects upstream closure and an early break
+// Errors: none
+// Buffering: N/A
+//
+// Breaking out of the loop stops reading and calls Stop on the object, which
+// halts a NewSlice/New/NewSeq source directly upstream. Deeper producers stay
+// blocked until ctx is cancelled, so pair an early break with cancellation.
+// The iterator is single-use: values consumed by one loop are gone.
+func Seq[T any](obj object[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range obj.ch {
+			if !yield(v) {
+				obj.Stop()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package lazy_test
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestNewSeq_InfiniteSeqStopsOnCancel(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stopped := make(chan struct{})
+	forever := func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	nums := lazy.NewSeq(ctx, forever)
+
+	var got []int
+	_ = lazy.ConsumeCtx(ctx, nums, func(v int) error {
+		got = append(got, v)
+		if len(got) == 3 {
+			cancel()
+		}
+		return nil
+	})
+	<-stopped
+
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(got[:3], want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestNewSeq_MapIterator(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	keys := lazy.NewSeq(ctx, maps.Keys(m))
+
+	got, err := lazy.Collect(keys)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	slices.Sort(got)
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestSeq_RangeWithEarlyBreak(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	squares := lazy.Map(ctx, lazy.New(ctx, naturals(ctx)), func(v int) (int, error) { return v * v, nil })
+
+	var got []int
+	for v := range lazy.Seq(squares) {
+		if v > 10 {
+			break
+		}
+		got = append(got, v)
+	}
+	cancel()
+
+	if want := []int{0, 1, 4, 9}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestSeq_FullRange(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got := slices.Collect(lazy.Seq(lazy.NewSlice(ctx, []string{"a", "b", "c"})))
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
@@ -46,3 +46,20 @@ func TestWithSize_NewBuffer(t *testing.T) {
 	// Clean up goroutine: close input channel so the wrapper goroutine exits.
 	close(userCh)
 }
+
+func TestWithSizeFunc_ByStageIndex(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	budget := WithSizeFunc(func(stage int) int { return 8 >> stage })
+	src := NewSlice[int](ctx, []int{1, 2, 3}, budget)
+	mapped := Map[int, int](ctx, src, func(v int) (int, error) { return v, nil }, budget)
+	filtered := Filter[int](ctx, mapped, func(v int) (bool, error) { return true, nil }, budget)
+
+	for i, c := range []int{cap(src.ch), cap(mapped.ch), cap(filtered.ch)} {
+		if want := 8 >> i; c != want {
+			t.Fatalf("stage %d: expected buffer=%d, got %d", i, want, c)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package lazy
+
+import "context"
+
+// Skip discards the first n values and forwards the rest.
+//
+// Input: object[T], n (values <= 0 pass everything through)
+// Output: object[T] (the input minus its first n values)
+// Order: preserves input order for emitted values
+// Cancellation: checks ctx.Done() while skipping; guards sends with select
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// Skipped values are drained eagerly, independent of downstream speed.
+func Skip[T any](ctx context.Context, obj object[T], n int, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		skipped := 0
+		for v := range obj.ch {
+			if skipped < n {
+				skipped++
+				if ctx.Err() != nil {
+					return
+				}
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
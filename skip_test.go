@@ -0,0 +1,48 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestSkip_DropsPrefix(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.Skip(ctx, lazy.NewSlice(ctx, []int{1, 2, 3, 4}), 2))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []int{3, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestSkip_TakePages(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	rows := []int{1, 2, 3, 4, 5, 6, 7}
+	page := func(n, size int) []int {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		src := lazy.NewSlice(ctx, rows)
+		got, err := lazy.Collect(lazy.Take(ctx, lazy.Skip(ctx, src, n*size), size))
+		if err != nil {
+			t.Fatalf("collect error: %v", err)
+		}
+		return got
+	}
+
+	want := [][]int{{1, 2, 3}, {4, 5, 6}, {7}, {}}
+	for i, w := range want {
+		if got := page(i, 3); !reflect.DeepEqual(got, w) {
+			t.Fatalf("page %d: got=%v want=%v", i, got, w)
+		}
+	}
+}
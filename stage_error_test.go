@@ -0,0 +1,52 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestStageError_CarriesStageIndex(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	stop := lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop })
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	doubled := lazy.Map(ctx, nums, func(v int) (int, error) { return v * 2, nil }, stop)
+	checked := lazy.Map(ctx, doubled, func(v int) (int, error) {
+		if v == 4 {
+			return 0, boom
+		}
+		return v, nil
+	}, stop)
+
+	err := lazy.ConsumeE(checked, func(int) error { return nil })
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+	var se *lazy.StageError
+	if !errors.As(err, &se) || se.Stage != 2 {
+		t.Fatalf("expected a StageError for stage 2, got %#v", err)
+	}
+	if err.Error() != "stage[2]: boom" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+}
+
+func TestStageError_SourceIsStageZero(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lines := lazy.NewScanner(ctx, &failingReader{err: errors.New("reset")},
+		lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+	err := lazy.ConsumeE(lines, func(string) error { return nil })
+	if err == nil || err.Error() != "stage[0]: reset" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+package lazy
+
+// Summarize drains the object, folding values into one accumulator per key.
+//
+// Input: object[T], key(T) K, init() ACC, fold(ACC, T) ACC
+// Output: (map[K]ACC, error); init seeds each key's accumulator on first sight
+// Order: N/A; values are folded in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: retains one accumulator per distinct key
+func Summarize[T any, K comparable, ACC any](obj object[T], key func(v T) K, init func() ACC, fold func(acc ACC, v T) ACC) (map[K]ACC, error) {
+	out := make(map[K]ACC)
+	for v := range obj.ch {
+		k := key(v)
+		acc, ok := out[k]
+		if !ok {
+			acc = init()
+		}
+		out[k] = fold(acc, v)
+	}
+	return out, obj.errs.get()
+}
@@ -0,0 +1,41 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+type item struct {
+	Category string
+	Price    int
+}
+
+func TestSummarize_TotalsPerCategory(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	items := lazy.NewSlice(ctx, []item{
+		{"food", 3},
+		{"tools", 10},
+		{"food", 4},
+	})
+
+	got, err := lazy.Summarize(items,
+		func(i item) string { return i.Category },
+		func() int { return 0 },
+		func(acc int, i item) int { return acc + i.Price },
+	)
+	if err != nil {
+		t.Fatalf("summarize error: %v", err)
+	}
+
+	want := map[string]int{"food": 7, "tools": 10}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
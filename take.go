@@ -0,0 +1,51 @@
+package lazy
+
+import "context"
+
+// Take forwards at most the first n values, then closes its output.
+//
+// Input: object[T], n (values <= 0 emit nothing)
+// Output: object[T] (a prefix of the input)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// After the nth value Take stops reading its input and calls Stop on it,
+// which halts a NewSlice/New source directly upstream. Any other upstream
+// stays blocked on its next send until ctx is cancelled, so pair Take with
+// cancellation (e.g. defer cancel()) when the input is longer than n.
+func Take[T any](ctx context.Context, obj object[T], n int, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		defer obj.Stop()
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for v := range obj.ch {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
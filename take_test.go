@@ -0,0 +1,60 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+// naturals produces 0, 1, 2, ... until ctx is cancelled.
+func naturals(ctx context.Context) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- i:
+			}
+		}
+	}()
+	return out
+}
+
+func TestTake_InfiniteSource(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.New(ctx, naturals(ctx))
+	doubled := lazy.Map(ctx, nums, func(v int) (int, error) { return v * 2, nil })
+	first := lazy.Take(ctx, doubled, 3)
+
+	got, err := lazy.Collect(first)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+
+	want := []int{0, 2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestTake_NonPositiveEmitsNothing(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.Take(ctx, lazy.NewSlice(ctx, []int{1, 2, 3}), 0))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no values, got %v", got)
+	}
+}
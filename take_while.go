@@ -0,0 +1,114 @@
+package lazy
+
+import "context"
+
+// TakeWhile forwards values while pred holds and closes its output at the
+// first value for which it does not.
+//
+// Input: object[T], pred(T) (bool, error)
+// Output: object[T] (the longest prefix satisfying pred)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore
+// Buffering: output channel capacity via WithSize
+//
+// The first failing value is not emitted. At that point TakeWhile stops
+// reading its input and calls Stop on it, which halts a NewSlice/New source
+// directly upstream; deeper producers stay blocked until ctx is cancelled, so
+// pair TakeWhile with cancellation.
+func TakeWhile[T any](ctx context.Context, obj object[T], pred func(v T) (bool, error), opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		defer obj.Stop()
+		for v := range obj.ch {
+			ok, err := pred(v)
+			if err != nil {
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			opt.handleSuccess()
+			if !ok {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
+
+// DropWhile discards values while pred holds and forwards everything from the
+// first value for which it does not.
+//
+// Input: object[T], pred(T) (bool, error)
+// Output: object[T] (the input minus its longest prefix satisfying pred)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore; Ignore
+// drops the value while still dropping
+// Buffering: output channel capacity via WithSize
+//
+// Once a value fails pred, pred is no longer called.
+func DropWhile[T any](ctx context.Context, obj object[T], pred func(v T) (bool, error), opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		dropping := true
+		for v := range obj.ch {
+			if dropping {
+				drop, err := pred(v)
+				if err != nil {
+					if decision := opt.handleError(err); decision == DecisionStop {
+						obj.errs.set(stage, err)
+						return
+					}
+					// DecisionIgnore: drop value and continue
+					continue
+				}
+				opt.handleSuccess()
+				if drop {
+					continue
+				}
+				dropping = false
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,80 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func lessThan(n int) func(int) (bool, error) {
+	return func(v int) (bool, error) { return v < n, nil }
+}
+
+func TestTakeWhile_StopsAtFirstFailure(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 1})
+	got, err := lazy.Collect(lazy.TakeWhile(ctx, nums, lessThan(4)))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestTakeWhile_InfiniteSource(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.Map(ctx, lazy.New(ctx, naturals(ctx)), func(v int) (int, error) { return v, nil })
+	got, err := lazy.Collect(lazy.TakeWhile(ctx, nums, lessThan(3)))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestDropWhile_ForwardsAfterFirstFailure(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 1})
+	got, err := lazy.Collect(lazy.DropWhile(ctx, nums, lessThan(4)))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{4, 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestDropWhile_StopOnError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	out := lazy.DropWhile(ctx, nums, func(v int) (bool, error) {
+		if v == 2 {
+			return false, boom
+		}
+		return true, nil
+	}, lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	err := lazy.ConsumeE(out, func(int) error { return nil })
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+}
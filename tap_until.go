@@ -0,0 +1,52 @@
+package lazy
+
+import "context"
+
+// TapUntil forwards values unchanged, calling fn on each, and ends the stream
+// right after the first value for which fn returns true.
+//
+// Input: object[T], fn(T) (stop bool)
+// Output: object[T] (values up to and including the stopping one)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// On stopping, TapUntil closes its output, calls Stop on its input (which
+// halts a NewSlice/New source directly upstream) and discards whatever the
+// input still delivers until it closes or ctx is cancelled, so upstream
+// stages such as Map or Filter are not left blocked. They still process their
+// remaining values; cancel ctx to cut that work short, and to release an
+// upstream that never ends on its own.
+func TapUntil[T any](ctx context.Context, obj object[T], fn func(v T) (stop bool), opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for v := range obj.ch {
+			stop := fn(v)
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+			if stop {
+				obj.Stop()
+				go drain(ctx, obj.ch)
+				return
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,64 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestTapUntil_StopsAfterThird(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var tapped []int
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	out := lazy.TapUntil(ctx, nums, func(v int) bool {
+		tapped = append(tapped, v)
+		return len(tapped) == 3
+	})
+
+	var got []int
+	if err := lazy.Consume(out, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if !reflect.DeepEqual(tapped, want) {
+		t.Fatalf("unexpected tapped values. got=%v want=%v", tapped, want)
+	}
+}
+
+func TestTapUntil_ReleasesNonSourceUpstream(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var g lazy.PipelineGroup
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5}, lazy.WithGroup(&g))
+	doubled := lazy.Map(ctx, nums, func(v int) (int, error) { return v * 2, nil }, lazy.WithGroup(&g))
+	out := lazy.TapUntil(ctx, doubled, func(v int) bool { return v == 4 }, lazy.WithGroup(&g))
+
+	got, err := lazy.Collect(out)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	// Map is not a source, so Stop cannot reach it; draining lets it finish
+	// without ctx being cancelled.
+	if err := g.Wait(); err != nil {
+		t.Fatalf("wait error: %v", err)
+	}
+	goleak.VerifyNone(t)
+}
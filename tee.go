@@ -0,0 +1,47 @@
+package lazy
+
+import "context"
+
+// Tee duplicates one stream into two.
+//
+// Input: object[T]
+// Output: (object[T], object[T]) each receiving every value
+// Order: preserves input order on both outputs
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: each output channel's capacity via WithSize
+//
+// A value is delivered to both outputs before the next one is read, so a
+// slow reader on one branch blocks the other once its buffer is full. Consume
+// both branches concurrently.
+func Tee[T any](ctx context.Context, obj object[T], opts ...optionFunc) (object[T], object[T]) {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch1 := make(chan T, opt.bufferSize(stage))
+	ch2 := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch1)
+		defer close(ch2)
+		for v := range obj.ch {
+			// Deliver to whichever branch is ready first.
+			out1, out2 := ch1, ch2
+			for out1 != nil || out2 != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case out1 <- v:
+					out1 = nil
+				case out2 <- v:
+					out2 = nil
+				}
+			}
+		}
+	}()
+
+	return object[T]{ch: ch1, stage: stage, errs: obj.errs},
+		object[T]{ch: ch2, stage: stage, errs: obj.errs}
+}
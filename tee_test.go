@@ -0,0 +1,37 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestTee_BothBranchesSeeAll(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	main, audit := lazy.Tee(ctx, lazy.NewSlice(ctx, []int{1, 2, 3, 4}))
+
+	var got1, got2 []int
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		got1, _ = lazy.Collect(main)
+	}()
+	go func() {
+		defer wg.Done()
+		got2, _ = lazy.Collect(audit)
+	}()
+	wg.Wait()
+
+	want := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(got1, want) || !reflect.DeepEqual(got2, want) {
+		t.Fatalf("unexpected result. got1=%v got2=%v want=%v", got1, got2, want)
+	}
+}
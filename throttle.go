@@ -0,0 +1,67 @@
+package lazy
+
+import (
+	"context"
+	"time"
+)
+
+// Throttle forwards at most one value per interval.
+//
+// Input: object[T], interval (values <= 0 disable throttling)
+// Output: object[T] (every input value, paced)
+// Order: preserves input order for emitted values
+// Cancellation: guards waits, receives and sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// The first value is forwarded immediately; each later one waits until
+// interval has passed since the previous send, measured on the stage clock
+// (see WithClock). Nothing is dropped: a fast input is slowed down through
+// backpressure.
+func Throttle[T any](ctx context.Context, obj object[T], interval time.Duration, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		var gate <-chan time.Time
+		for {
+			var v T
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok = <-obj.ch:
+			}
+			if !ok {
+				return
+			}
+			if gate != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-gate:
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+			if interval > 0 {
+				gate = opt.clock.After(interval)
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
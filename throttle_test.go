@@ -0,0 +1,69 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestThrottle_PacesValues(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const interval = 20 * time.Millisecond
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4})
+
+	start := time.Now()
+	got, err := lazy.Collect(lazy.Throttle(ctx, nums, interval))
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if elapsed < 3*interval {
+		t.Fatalf("4 values took %v, want at least %v", elapsed, 3*interval)
+	}
+}
+
+func TestThrottle_FakeClock(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clock := newFakeClock()
+	nums := lazy.NewSlice(ctx, []int{1, 2})
+	throttled := lazy.Throttle(ctx, nums, time.Second, lazy.WithClock(clock))
+
+	next := make(chan int)
+	go func() {
+		defer close(next)
+		_ = lazy.Consume(throttled, func(v int) error {
+			next <- v
+			return nil
+		})
+	}()
+
+	if v := <-next; v != 1 {
+		t.Fatalf("first value: got %d want 1", v)
+	}
+	clock.BlockUntil(1)
+	select {
+	case v := <-next:
+		t.Fatalf("value %d passed before the interval", v)
+	case <-time.After(20 * time.Millisecond):
+	}
+	clock.Advance(time.Second)
+	if v := <-next; v != 2 {
+		t.Fatalf("second value: got %d want 2", v)
+	}
+	if _, ok := <-next; ok {
+		t.Fatal("expected stream to end")
+	}
+}
@@ -0,0 +1,57 @@
+package lazy
+
+import (
+	"context"
+	"time"
+)
+
+// Ticker emits the current time every interval.
+//
+// Input: interval time.Duration (must be > 0)
+// Output: object[time.Time] (tick times from the stage clock, see WithClock)
+// Order: ticks in time order
+// Cancellation: stops ticking and closes when ctx.Done() or Stop() is called
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// The stream never ends on its own; bound it with Take or ctx. Like
+// time.Ticker, ticks are dropped while the consumer is not keeping up.
+func Ticker(ctx context.Context, interval time.Duration, opts ...optionFunc) object[time.Time] {
+	opt := buildOpts(opts)
+	ch := make(chan time.Time, opt.bufferSize(0))
+	done, stop := newStopper()
+	ticker := opt.clock.NewTicker(interval)
+
+	errs := newErrCell()
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		defer ticker.Stop()
+		for {
+			var now time.Time
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case now = <-ticker.C():
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case ch <- now:
+			}
+		}
+	}()
+
+	return object[time.Time]{
+		ch:   ch,
+		stop: stop,
+		errs: errs,
+	}
+}
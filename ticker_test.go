@@ -0,0 +1,37 @@
+package lazy_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestTicker_FakeClockTicks(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clock := newFakeClock()
+	ticks := lazy.Take(ctx, lazy.Ticker(ctx, time.Second, lazy.WithClock(clock)), 3)
+
+	got := make(chan time.Time)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = lazy.Consume(ticks, func(v time.Time) error {
+			got <- v
+			return nil
+		})
+	}()
+
+	for i := 1; i <= 3; i++ {
+		clock.Advance(time.Second)
+		if v, want := <-got, time.Unix(int64(i), 0); !v.Equal(want) {
+			t.Fatalf("tick %d: got %v want %v", i, v, want)
+		}
+	}
+	<-done
+}
@@ -0,0 +1,115 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithTimeout_DropsHungItem(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	release := make(chan struct{})
+	defer close(release)
+
+	var seen []error
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			<-release
+		}
+		return v, nil
+	}, lazy.WithTimeout(10*time.Millisecond), lazy.WithErrHandler(func(err error) lazy.Decision {
+		seen = append(seen, err)
+		return lazy.DecisionIgnore
+	}))
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if len(seen) != 1 || !errors.Is(seen[0], context.DeadlineExceeded) {
+		t.Fatalf("unexpected handler errors %v", seen)
+	}
+}
+
+func TestWithTimeout_StopOnHungItem(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	release := make(chan struct{})
+	defer close(release)
+
+	clock := newFakeClock()
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v == 2 {
+			<-release
+		}
+		return v, nil
+	}, lazy.WithTimeout(time.Second), lazy.WithClock(clock),
+		lazy.WithErrHandler(func(err error) lazy.Decision { return lazy.DecisionStop }))
+
+	go func() {
+		// Every call arms a timer; the one for 1 stays pending after it
+		// returned, so wait for the second before firing.
+		clock.BlockUntil(2)
+		clock.Advance(time.Second)
+	}()
+	var got []int
+	err := lazy.ConsumeE(mapped, func(v int) error {
+		got = append(got, v)
+		return nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+	if want := []int{1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestWithTimeout_HooksStayOnStageGoroutine(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Unsynchronized on purpose: the race detector flags hooks that run on
+	// the abandoned call's goroutine.
+	var events []string
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3})
+	mapped := lazy.MapCtx(ctx, nums, func(ctx context.Context, v int) (int, error) {
+		if v == 1 {
+			<-ctx.Done()
+			time.Sleep(5 * time.Millisecond) // finish while item 2 is in flight
+		}
+		return v, nil
+	}, lazy.WithTimeout(10*time.Millisecond), lazy.WithItemHooks(
+		func(v any) { events = append(events, fmt.Sprint("before ", v)) },
+		func(v any, _ any, err error, _ time.Duration) {
+			events = append(events, fmt.Sprint("after ", v, " ", err != nil))
+		},
+	))
+
+	got, err := lazy.Collect(mapped)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if want := []int{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	want := []string{"before 1", "after 1 true", "before 2", "after 2 false", "before 3", "after 3 false"}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("unexpected hook events %v", events)
+	}
+}
@@ -0,0 +1,23 @@
+package lazy
+
+// ToMap drains the object into a map keyed by kvFn.
+//
+// Input: object[T], kvFn(v T) (K, V, error)
+// Output: (map[K]V, error) non-nil even when empty
+// Order: inserts values in upstream order; on a duplicate key the last
+// value wins
+// Cancellation: N/A; respects upstream closure
+// Errors: returns the first kvFn error together with the map built so far;
+// if the stream closed because a stage hit DecisionStop, that stage's error
+// Buffering: retains one entry per distinct key
+func ToMap[T any, K comparable, V any](obj object[T], kvFn func(v T) (K, V, error)) (map[K]V, error) {
+	out := map[K]V{}
+	for v := range obj.ch {
+		k, val, err := kvFn(v)
+		if err != nil {
+			return out, err
+		}
+		out[k] = val
+	}
+	return out, obj.errs.get()
+}
@@ -0,0 +1,66 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestToMap_Values(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.ToMap(lazy.NewSlice(ctx, []int{1, 2, 3}), func(v int) (int, int, error) {
+		return v, v * v, nil
+	})
+	if err != nil {
+		t.Fatalf("to map error: %v", err)
+	}
+	want := map[int]int{1: 1, 2: 4, 3: 9}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestToMap_DuplicateKeyLastWins(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.ToMap(lazy.NewSlice(ctx, []string{"apple", "avocado", "banana"}), func(s string) (byte, string, error) {
+		return s[0], s, nil
+	})
+	if err != nil {
+		t.Fatalf("to map error: %v", err)
+	}
+	want := map[byte]string{'a': "avocado", 'b': "banana"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestToMap_ErrorReturnsPartial(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	boom := errors.New("boom")
+	got, err := lazy.ToMap(lazy.NewSlice(ctx, []int{1, 2, 3}), func(v int) (int, int, error) {
+		if v == 3 {
+			return 0, 0, boom
+		}
+		return v, v, nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	want := map[int]int{1: 1, 2: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected partial map. got=%v want=%v", got, want)
+	}
+}
@@ -0,0 +1,65 @@
+package lazy
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// TopN drains the object and returns its n largest values.
+//
+// Input: object[T], n (values < 1 return an empty slice), less(a, b T) bool
+// Output: ([]T, error) up to n values sorted descending by less
+// Order: descending; ties keep no particular order
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: retains at most n values in a min-heap
+func TopN[T any](obj object[T], n int, less func(a, b T) bool) ([]T, error) {
+	h := &boundedHeap[T]{items: []T{}, less: less}
+	for v := range obj.ch {
+		h.offer(v, n)
+	}
+	out := h.items
+	slices.SortFunc(out, func(a, b T) int { return compareBy(less, b, a) })
+	return out, obj.errs.get()
+}
+
+// boundedHeap keeps the n greatest values seen under less, with the smallest
+// of them at the root so it can be evicted in O(log n).
+type boundedHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *boundedHeap[T]) Len() int           { return len(h.items) }
+func (h *boundedHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *boundedHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *boundedHeap[T]) Push(x any)         { h.items = append(h.items, x.(T)) }
+func (h *boundedHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// offer adds v if fewer than n values are held or v beats the smallest one.
+func (h *boundedHeap[T]) offer(v T, n int) {
+	switch {
+	case n < 1:
+	case len(h.items) < n:
+		heap.Push(h, v)
+	case h.less(h.items[0], v):
+		h.items[0] = v
+		heap.Fix(h, 0)
+	}
+}
+
+// compareBy turns a less function into a three-way comparison.
+func compareBy[T any](less func(a, b T) bool, a, b T) int {
+	switch {
+	case less(a, b):
+		return -1
+	case less(b, a):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,40 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestTopN_LargestDescending(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{5, 1, 9, 3, 7})
+	got, err := lazy.TopN(nums, 3, func(a, b int) bool { return a < b })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{9, 7, 5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestTopN_FewerThanN(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{2, 8})
+	got, err := lazy.TopN(nums, 5, func(a, b int) bool { return a < b })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{8, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
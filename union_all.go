@@ -0,0 +1,25 @@
+package lazy
+
+// UnionAll drains a stream of slices and returns the distinct elements.
+//
+// Input: object[[]T comparable]
+// Output: ([]T, error) each distinct element once; non-nil even when empty
+// Order: first-seen order across slices
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: retains every distinct element
+func UnionAll[T comparable](obj object[[]T]) ([]T, error) {
+	out := []T{}
+	seen := make(map[T]struct{})
+	for vs := range obj.ch {
+		for _, v := range vs {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	return out, obj.errs.get()
+}
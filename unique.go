@@ -0,0 +1,23 @@
+package lazy
+
+// Unique drains the object and returns its distinct values.
+//
+// Input: object[T comparable]
+// Output: ([]T, error) distinct values in first-seen order; non-nil when empty
+// Order: first occurrence order
+// Cancellation: N/A; respects upstream closure
+// Errors: if the stream closed because a stage hit DecisionStop, that stage's
+// error, together with the result over the values seen before it
+// Buffering: retains every distinct value (fully buffering terminal)
+func Unique[T comparable](obj object[T]) ([]T, error) {
+	out := []T{}
+	seen := make(map[T]struct{})
+	for v := range obj.ch {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out, obj.errs.get()
+}
@@ -0,0 +1,25 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestUnique_FirstSeenOrder(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Unique(lazy.NewSlice(ctx, []int{3, 1, 3, 2, 1}))
+	if err != nil {
+		t.Fatalf("unique error: %v", err)
+	}
+	want := []int{3, 1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
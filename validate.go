@@ -0,0 +1,50 @@
+package lazy
+
+import "context"
+
+// Validate checks invariant for each value and forwards values that pass.
+//
+// Input: object[T], invariant(T) error
+// Output: object[T] (values are forwarded unchanged)
+// Order: preserves input order for emitted values
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: invariant violations handled via WithErrHandler → DecisionStop | DecisionIgnore
+// Buffering: output channel capacity via WithSize
+//
+// With the default handler a violating value is dropped; pass a handler
+// returning DecisionStop to truncate the stream at the first violation.
+func Validate[T any](ctx context.Context, obj object[T], invariant func(v T) error, opts ...optionFunc) object[T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		for v := range obj.ch {
+			if err := invariant(v); err != nil {
+				if decision := opt.handleError(err); decision == DecisionStop {
+					obj.errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: drop value and continue
+				continue
+			}
+			opt.handleSuccess()
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
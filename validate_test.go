@@ -0,0 +1,72 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+var errNonPositive = errors.New("id must be positive")
+
+func positive(v int) error {
+	if v <= 0 {
+		return errNonPositive
+	}
+	return nil
+}
+
+func TestValidate_DefaultIgnoreError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ids := lazy.NewSlice(ctx, []int{1, 2, -3, 4})
+	valid := lazy.Validate(ctx, ids, positive)
+
+	var got []int
+	if err := lazy.Consume(valid, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []int{1, 2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestValidate_StopOnError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var seen error
+	ids := lazy.NewSlice(ctx, []int{1, 2, -3, 4})
+	valid := lazy.Validate(ctx, ids, positive, lazy.WithErrHandler(func(err error) lazy.Decision {
+		seen = err
+		return lazy.DecisionStop
+	}))
+
+	var got []int
+	var stageErr *lazy.StageError
+	if err := lazy.Consume(valid, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); !errors.As(err, &stageErr) || stageErr.Stage != 1 {
+		t.Fatalf("expected stage 1 error, got %v", err)
+	}
+
+	want := []int{1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+	if !errors.Is(seen, errNonPositive) {
+		t.Fatalf("expected handler to see %v, got %v", errNonPositive, seen)
+	}
+}
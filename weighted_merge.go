@@ -0,0 +1,80 @@
+package lazy
+
+import "context"
+
+// WeightedMerge fans in several streams, taking up to weights[i] values from
+// sources[i] per round.
+//
+// Input: sources []object[T], weights []int (missing or < 1 weights count as 1)
+// Output: object[T] (every value of every source)
+// Order: preserved within each source; rounds visit sources in slice order
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: none
+// Buffering: unbuffered output
+//
+// Sources are pulled one at a time, so a source that is slow to produce
+// delays the others during its turn. A closed source leaves the rotation; the
+// output closes when all have. On cancellation Stop is called on every source.
+func WeightedMerge[T any](ctx context.Context, sources []object[T], weights []int) object[T] {
+	stage := 0
+	errs := newErrCell()
+	for _, src := range sources {
+		stage = max(stage, src.stage)
+		errs.link(src.errs)
+	}
+	stage++
+	ch := make(chan T)
+
+	type slot struct {
+		src    object[T]
+		weight int
+	}
+	active := make([]slot, len(sources))
+	for i, src := range sources {
+		w := 1
+		if i < len(weights) && weights[i] > 1 {
+			w = weights[i]
+		}
+		active[i] = slot{src: src, weight: w}
+	}
+
+	go func() {
+		defer recover()
+		defer close(ch)
+		defer func() {
+			for _, s := range active {
+				s.src.Stop()
+			}
+		}()
+		for len(active) > 0 {
+			for i := 0; i < len(active); i++ {
+				s := active[i]
+				for range s.weight {
+					var v T
+					var ok bool
+					select {
+					case <-ctx.Done():
+						return
+					case v, ok = <-s.src.ch:
+					}
+					if !ok {
+						active = append(active[:i], active[i+1:]...)
+						i--
+						break
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case ch <- v:
+					}
+				}
+			}
+		}
+	}()
+
+	return object[T]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
@@ -0,0 +1,57 @@
+package lazy
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"go.uber.org/goleak"
+)
+
+// Whitebox: the sources slice is a []object[T], which package lazy_test
+// cannot name.
+func TestWeightedMerge_ProportionalToWeights(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	heavy := New(ctx, counter(ctx))
+	light := Map(ctx, New(ctx, counter(ctx)), func(v int) (int, error) {
+		return -1 - v, nil
+	})
+	merged := Take(ctx, WeightedMerge(ctx, []object[int]{heavy, light}, []int{3, 1}), 40)
+
+	got, err := Collect(merged)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+
+	fromHeavy := 0
+	for _, v := range got {
+		if v >= 0 {
+			fromHeavy++
+		}
+	}
+	if fromHeavy != 30 {
+		t.Fatalf("expected 30 of 40 from the weight-3 source, got %d: %v", fromHeavy, got)
+	}
+	if !slices.Equal(got[:8], []int{0, 1, 2, -1, 3, 4, 5, -2}) {
+		t.Fatalf("unexpected interleaving: %v", got[:8])
+	}
+}
+
+// counter produces 0, 1, 2, ... until ctx is cancelled.
+func counter(ctx context.Context) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- i:
+			}
+		}
+	}()
+	return out
+}
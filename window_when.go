@@ -0,0 +1,53 @@
+package lazy
+
+import "context"
+
+// WindowWhen groups values into slices, flushing when shouldFlush says so.
+//
+// Input: object[T], shouldFlush(buffer []T, next T) bool
+// Output: object[[]T] (non-empty windows; the last may be partial)
+// Order: preserves input order across and within windows
+// Cancellation: guards sends with select on ctx.Done()
+// Errors: none
+// Buffering: output channel capacity via WithSize
+//
+// Before next is appended, shouldFlush is asked whether the current (non-empty)
+// buffer should be emitted first; next then starts the new window. buffer must
+// not be retained by shouldFlush. Remaining values are flushed when the input
+// closes.
+func WindowWhen[T any](ctx context.Context, obj object[T], shouldFlush func(buffer []T, next T) bool, opts ...optionFunc) object[[]T] {
+	opt := buildOpts(opts)
+	stage := obj.stage + 1
+	ch := make(chan []T, opt.bufferSize(stage))
+
+	opt.group.add(obj.errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		var buf []T
+		for v := range obj.ch {
+			if len(buf) > 0 && shouldFlush(buf, v) {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- buf:
+				}
+				buf = nil
+			}
+			buf = append(buf, v)
+		}
+		if len(buf) > 0 {
+			select {
+			case <-ctx.Done():
+			case ch <- buf:
+			}
+		}
+	}()
+
+	return object[[]T]{
+		ch:    ch,
+		stage: stage,
+		errs:  obj.errs,
+	}
+}
@@ -0,0 +1,35 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWindowWhen_SumThreshold(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sizes := lazy.NewSlice(ctx, []int{4, 5, 3, 8, 1, 1})
+	windows := lazy.WindowWhen(ctx, sizes, func(buf []int, next int) bool {
+		sum := next
+		for _, v := range buf {
+			sum += v
+		}
+		return sum > 10
+	})
+
+	got, err := lazy.Collect(windows)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+
+	want := [][]int{{4, 5}, {3}, {8, 1, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
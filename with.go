@@ -1,16 +1,65 @@
 package lazy
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
 type option struct {
-	size    int
-	onError errHandlerFunc
+	size     int
+	sizeFunc func(stage int) int
+	onError  errHandlerFunc
+	// onErrorSet reports whether WithErrHandler was given explicitly.
+	onErrorSet bool
+	clock      Clock
+	header     bool
+
+	// maxConsecutive trips the stage after that many back-to-back errors.
+	maxConsecutive int
+	consecutive    int
+
+	// rate trips the stage when the windowed error ratio is too high.
+	rate *rateWindow
+
+	retryAttempts int
+	retryBackoff  func(attempt int) time.Duration
+
+	// maxBuffered caps the items a buffering stage retains; 0 means no cap.
+	maxBuffered int
+
+	hookBefore func(v any)
+	hookAfter  func(v any, out any, err error, dur time.Duration)
+
+	controller *Controller
+
+	timeout time.Duration
+
+	panicAsError bool
+
+	observer Observer
+
+	// samples keeps failing inputs for WithErrorSamples.
+	samples *errorSamples
+
+	// inputClone holds a func(IN) IN set by WithInputClone.
+	inputClone any
+
+	arrivalStats func(min, max, mean time.Duration)
+
+	group *PipelineGroup
 }
 
 type optionFunc func(opts *option)
 
 func buildOpts(opts []optionFunc) option {
 	opt := option{
-		size:    0,
-		onError: IgnoreErrorHandler,
+		size:     0,
+		onError:  IgnoreErrorHandler,
+		clock:    realClock{},
+		observer: noopObserver{},
 	}
 	for _, f := range opts {
 		f(&opt)
@@ -21,16 +70,39 @@ func buildOpts(opts []optionFunc) option {
 func WithSize(size int) optionFunc {
 	return func(opts *option) {
 		opts.size = size
+		opts.sizeFunc = nil
+	}
+}
+
+// WithSizeFunc sizes the output channel by the stage's zero-based index in
+// the chain (sources are 0), e.g. to shrink buffers as pipelines get deeper.
+// Pass the same fn to every stage for a chain-wide budget. It overrides
+// WithSize and vice versa; the last one given wins.
+func WithSizeFunc(fn func(stage int) int) optionFunc {
+	return func(opts *option) {
+		opts.sizeFunc = fn
+	}
+}
+
+// bufferSize is the output channel capacity for a stage at index stage.
+func (o option) bufferSize(stage int) int {
+	if o.sizeFunc != nil {
+		return o.sizeFunc(stage)
 	}
+	return o.size
 }
 
+// Decision tells a stage what to do after a value failed.
 type Decision string
 
 const (
-	DecisionStop   = "stop"
-	DecisionIgnore = "ignore"
+	// DecisionStop ends the stage and records the error for the terminal.
+	DecisionStop Decision = "stop"
+	// DecisionIgnore drops the failed value and keeps going.
+	DecisionIgnore Decision = "ignore"
 )
 
+// errHandlerFunc decides how a stage reacts to an error; see WithErrHandler.
 type errHandlerFunc func(err error) Decision
 
 var (
@@ -39,8 +111,367 @@ var (
 	}
 )
 
+// CollectErrorHandler returns a handler that records every error it sees and
+// always answers DecisionIgnore, together with a getter for the recorded
+// errors. Recording is safe for concurrent stages; call the getter only after
+// Consume (or another terminal) returns, when no stage can add more.
+func CollectErrorHandler() (errHandlerFunc, func() []error) {
+	var mu sync.Mutex
+	var errs []error
+	handler := func(err error) Decision {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+		return DecisionIgnore
+	}
+	collected := func() []error {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]error(nil), errs...)
+	}
+	return handler, collected
+}
+
 func WithErrHandler(handler errHandlerFunc) optionFunc {
 	return func(opts *option) {
 		opts.onError = handler
+		opts.onErrorSet = true
+	}
+}
+
+// WithHeader marks the first record of a tabular source (e.g. FromCSV) as a
+// header to be skipped.
+func WithHeader() optionFunc {
+	return func(opts *option) {
+		opts.header = true
+	}
+}
+
+// WithMaxConsecutiveErrors stops the stage once n user-function errors occur
+// with no successful item in between, regardless of the error handler's
+// decision. Any success resets the count. n <= 0 disables the breaker.
+func WithMaxConsecutiveErrors(n int) optionFunc {
+	return func(opts *option) {
+		opts.maxConsecutive = n
+	}
+}
+
+// WithErrorRateBreaker stops the stage when the fraction of failed items over
+// the trailing window exceeds threshold (0..1). Outcomes are timestamped with
+// the stage clock (see WithClock). The ratio is checked on every error once
+// the window holds at least five outcomes, so a few early failures cannot
+// trip it before there is enough evidence.
+func WithErrorRateBreaker(window time.Duration, threshold float64) optionFunc {
+	return func(opts *option) {
+		opts.rate = &rateWindow{window: window, threshold: threshold}
+	}
+}
+
+// WithRetry makes Map and Filter call the user function up to attempts times
+// before treating its error as a failure. Between tries the stage waits
+// backoff(attempt), where attempt counts the failed tries so far (1-based);
+// the wait uses the stage clock and is cut short by ctx cancellation. A nil
+// backoff retries immediately. Only the final error reaches WithErrHandler.
+func WithRetry(attempts int, backoff func(attempt int) time.Duration) optionFunc {
+	return func(opts *option) {
+		opts.retryAttempts = attempts
+		opts.retryBackoff = backoff
+	}
+}
+
+// WithItemHooks makes Map call before right before and after right after each
+// mapper call, with the mapper's own duration measured on the stage clock.
+// Send and backpressure time is excluded, so the hooks isolate mapper cost.
+// Either hook may be nil. With WithRetry they fire once per attempt. Hooks
+// run on the stage goroutine, one item at a time; under WithTimeout, after
+// sees the timeout error when the mapper call is abandoned.
+func WithItemHooks(before func(v any), after func(v any, out any, err error, dur time.Duration)) optionFunc {
+	return func(opts *option) {
+		opts.hookBefore = before
+		opts.hookAfter = after
+	}
+}
+
+// hooked wraps one call of a user function with the WithItemHooks hooks. It
+// must run on the stage goroutine, outside timed, so hooks never overlap.
+func hooked[IN any, OUT any](opt *option, v IN, fn func(v IN) (OUT, error)) (OUT, error) {
+	if opt.hookBefore == nil && opt.hookAfter == nil {
+		return fn(v)
+	}
+	if opt.hookBefore != nil {
+		opt.hookBefore(v)
+	}
+	start := opt.clock.Now()
+	out, err := fn(v)
+	if opt.hookAfter != nil {
+		opt.hookAfter(v, out, err, opt.clock.Now().Sub(start))
+	}
+	return out, err
+}
+
+// WithTimeout makes Map and MapCtx give up on a mapper call that has not
+// returned within d (on the stage clock) and treat it as an error wrapping
+// context.DeadlineExceeded, fed through WithErrHandler. The call is abandoned,
+// not killed: its result is discarded, and only a MapCtx mapper learns of the
+// timeout, through its cancelled context. d <= 0 disables the timeout.
+func WithTimeout(d time.Duration) optionFunc {
+	return func(opts *option) {
+		opts.timeout = d
+	}
+}
+
+// timed runs fn under the WithTimeout limit. fn's context is cancelled when
+// the limit is hit, so a context-aware call can stop early.
+func timed[OUT any](ctx context.Context, opt *option, fn func(ctx context.Context) (OUT, error)) (OUT, error) {
+	if opt.timeout <= 0 {
+		return fn(ctx)
+	}
+	type result struct {
+		out OUT
+		err error
+	}
+	callCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan result, 1) // buffered: an abandoned call must not block
+	go func() {
+		out, err := fn(callCtx)
+		done <- result{out, err}
+	}()
+	var zero OUT
+	select {
+	case r := <-done:
+		return r.out, r.err
+	case <-opt.clock.After(opt.timeout):
+		return zero, fmt.Errorf("mapper did not return within %v: %w", opt.timeout, context.DeadlineExceeded)
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// ErrorSample is one failing input kept by WithErrorSamples.
+type ErrorSample struct {
+	Input any
+	Err   error
+}
+
+// errorSamples retains the first n failures it is offered.
+type errorSamples struct {
+	mu      sync.Mutex
+	n       int
+	samples []ErrorSample
+}
+
+// record keeps v and err if fewer than n samples are held. Safe on nil.
+func (s *errorSamples) record(v any, err error) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.samples) < s.n {
+		s.samples = append(s.samples, ErrorSample{Input: v, Err: err})
+	}
+}
+
+// WithErrorSamples makes Map and Filter keep the first n failing inputs with
+// their errors, as representative failures without logging every one. It
+// returns the option and an accessor; call the accessor only after Consume
+// (or another terminal) returns. Pass the option to several stages to share
+// one sample set.
+func WithErrorSamples(n int) (optionFunc, func() []ErrorSample) {
+	s := &errorSamples{n: n}
+	option := func(opts *option) {
+		opts.samples = s
+	}
+	samples := func() []ErrorSample {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return append([]ErrorSample(nil), s.samples...)
+	}
+	return option, samples
+}
+
+// ErrPanic wraps a panic recovered from a user function under
+// WithPanicAsError.
+var ErrPanic = errors.New("panic")
+
+// WithPanicAsError makes Map and Filter recover a panic in the user function
+// and treat it as that value's error, wrapped in ErrPanic, so WithErrHandler
+// and the breakers decide what happens next. Without it a panic crashes the
+// program.
+func WithPanicAsError() optionFunc {
+	return func(opts *option) {
+		opts.panicAsError = true
+	}
+}
+
+// guarded calls fn, turning a panic into an error under WithPanicAsError.
+func guarded[IN any, OUT any](opt *option, v IN, fn func(v IN) (OUT, error)) (out OUT, err error) {
+	if opt.panicAsError {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%w: %v", ErrPanic, r)
+			}
+		}()
+	}
+	return fn(v)
+}
+
+// ErrBufferExceeded is reported by a buffering stage that would retain more
+// items than WithMaxBufferedItems allows.
+var ErrBufferExceeded = errors.New("lazy: buffered items limit exceeded")
+
+// WithMaxBufferedItems caps how many items a buffering stage (Distinct,
+// DistinctFunc, Backfill) or terminal (GroupBy) retains. The item that would
+// exceed the cap is reported as ErrBufferExceeded: the stage stops unless
+// WithErrHandler is given, in which case DecisionIgnore skips retaining that
+// item (Distinct, DistinctFunc and GroupBy drop it; Backfill forwards it
+// without remembering its key). n < 1 means no cap.
+func WithMaxBufferedItems(n int) optionFunc {
+	return func(opts *option) {
+		opts.maxBuffered = n
+	}
+}
+
+// exceedsBuffer reports whether retaining one more item beyond retained
+// would break the WithMaxBufferedItems cap.
+func (o *option) exceedsBuffer(retained int) bool {
+	return o.maxBuffered > 0 && retained >= o.maxBuffered
+}
+
+// handleBufferExceeded decides on ErrBufferExceeded. Unlike user-function
+// errors it stops by default, since ignoring it silently breaks the stage's
+// guarantee.
+func (o *option) handleBufferExceeded() Decision {
+	if !o.onErrorSet {
+		return DecisionStop
+	}
+	return o.handleError(ErrBufferExceeded)
+}
+
+// WithInputClone makes ParallelMap and ParallelMapOrdered pass each worker a
+// copy of its input made by clone, so mappers may mutate inputs that share
+// backing storage (e.g. pooled buffers) without racing. IN must match the
+// stage's input type; otherwise the option has no effect.
+func WithInputClone[IN any](clone func(v IN) IN) optionFunc {
+	return func(opts *option) {
+		opts.inputClone = clone
+	}
+}
+
+// cloneInput applies the WithInputClone function when it accepts IN.
+func cloneInput[IN any](opt *option, v IN) IN {
+	if clone, ok := opt.inputClone.(func(v IN) IN); ok {
+		return clone(v)
+	}
+	return v
+}
+
+// WithObserver reports a stage's emits, errors and drops to obs.
+// A nil obs restores the default no-op observer.
+func WithObserver(obs Observer) optionFunc {
+	return func(opts *option) {
+		if obs == nil {
+			obs = noopObserver{}
+		}
+		opts.observer = obs
+	}
+}
+
+// WithController attaches a shared Controller to a stage, so that NewSlice,
+// New, Map and Filter block before their next value while it is paused.
+func WithController(c *Controller) optionFunc {
+	return func(opts *option) {
+		opts.controller = c
+	}
+}
+
+// WithClock replaces the wall clock used by time-based behavior of a stage.
+func WithClock(c Clock) optionFunc {
+	return func(opts *option) {
+		opts.clock = c
+	}
+}
+
+// handleError applies the stage's error policy to a user-function error.
+// The error handler is always consulted; breakers may override its decision.
+func (o *option) handleError(err error) Decision {
+	decision := o.onError(err)
+	o.consecutive++
+	if o.maxConsecutive > 0 && o.consecutive >= o.maxConsecutive {
+		return DecisionStop
+	}
+	if o.rate != nil && o.rate.record(o.clock.Now(), true) {
+		return DecisionStop
+	}
+	return decision
+}
+
+// handleSuccess records a successful user-function call.
+func (o *option) handleSuccess() {
+	o.consecutive = 0
+	if o.rate != nil {
+		o.rate.record(o.clock.Now(), false)
+	}
+}
+
+// WithArrivalStats makes a source (NewSlice, New, NewSeq, NewScanner) time
+// the gaps between successive values reaching it, on the stage clock, and
+// report their min, max and mean to fn once the source closes. With fewer
+// than two values all three are zero.
+func WithArrivalStats(fn func(min, max, mean time.Duration)) optionFunc {
+	return func(opts *option) {
+		opts.arrivalStats = fn
+	}
+}
+
+// arrivals accumulates inter-arrival gaps for WithArrivalStats. All methods
+// are safe on a nil tracker, which is what newArrivals returns when the
+// option is unset.
+type arrivals struct {
+	clock         Clock
+	report        func(min, max, mean time.Duration)
+	last          time.Time
+	seen          bool
+	gaps          int
+	min, max, sum time.Duration
+}
+
+func newArrivals(opt *option) *arrivals {
+	if opt.arrivalStats == nil {
+		return nil
+	}
+	return &arrivals{clock: opt.clock, report: opt.arrivalStats}
+}
+
+// arrived records that a value reached the source.
+func (a *arrivals) arrived() {
+	if a == nil {
+		return
+	}
+	now := a.clock.Now()
+	if a.seen {
+		gap := now.Sub(a.last)
+		if a.gaps == 0 || gap < a.min {
+			a.min = gap
+		}
+		if gap > a.max {
+			a.max = gap
+		}
+		a.sum += gap
+		a.gaps++
+	}
+	a.last, a.seen = now, true
+}
+
+// close reports the accumulated stats.
+func (a *arrivals) close() {
+	if a == nil {
+		return
+	}
+	var mean time.Duration
+	if a.gaps > 0 {
+		mean = a.sum / time.Duration(a.gaps)
 	}
+	a.report(a.min, a.max, mean)
 }
@@ -0,0 +1,67 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWithMaxConsecutiveErrors_AlternatingNeverTrips(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6, 7, 8})
+	odds := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v%2 == 0 {
+			return 0, errors.New("even")
+		}
+		return v, nil
+	}, lazy.WithMaxConsecutiveErrors(2))
+
+	var got []int
+	if err := lazy.Consume(odds, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("consume error: %v", err)
+	}
+
+	want := []int{1, 3, 5, 7}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestWithMaxConsecutiveErrors_TripsOnStreak(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5, 6})
+	mapped := lazy.Map(ctx, nums, func(v int) (int, error) {
+		if v >= 3 && v <= 5 {
+			return 0, errors.New("down")
+		}
+		return v, nil
+	}, lazy.WithMaxConsecutiveErrors(3))
+
+	var got []int
+	var stageErr *lazy.StageError
+	if err := lazy.Consume(mapped, func(v int) error {
+		got = append(got, v)
+		return nil
+	}); !errors.As(err, &stageErr) || stageErr.Stage != 1 {
+		t.Fatalf("expected stage 1 error, got %v", err)
+	}
+
+	// 3, 4, 5 fail back-to-back, so 6 is never emitted.
+	want := []int{1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
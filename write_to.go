@@ -0,0 +1,31 @@
+package lazy
+
+import "io"
+
+// WriteTo drains the object into w, writing format(v) for each value.
+//
+// Input: object[T], w io.Writer, format(T) string (include newlines yourself)
+// Output: (int, error) number of values written, first write/flush error
+// Order: writes values in upstream order
+// Cancellation: N/A; respects upstream closure
+// Errors: returns the first write error, or the flush error; if the stream
+// closed because a stage hit DecisionStop, that stage's error (after flushing)
+// Buffering: N/A
+//
+// If w has a Flush() error method (e.g. *bufio.Writer), it is flushed before
+// returning on success.
+func WriteTo[T any](obj object[T], w io.Writer, format func(v T) string) (int, error) {
+	n := 0
+	for v := range obj.ch {
+		if _, err := io.WriteString(w, format(v)); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if f, ok := w.(interface{ Flush() error }); ok {
+		if err := f.Flush(); err != nil {
+			return n, err
+		}
+	}
+	return n, obj.errs.get()
+}
@@ -0,0 +1,46 @@
+package lazy_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestWriteTo_Concatenates(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var buf bytes.Buffer
+	n, err := lazy.WriteTo(lazy.NewSlice(ctx, []int{1, 2, 3}), &buf, func(v int) string {
+		return fmt.Sprintf("n=%d\n", v)
+	})
+	if err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if want := "n=1\nn=2\nn=3\n"; n != 3 || buf.String() != want {
+		t.Fatalf("got n=%d out=%q, want n=3 out=%q", n, buf.String(), want)
+	}
+}
+
+func TestWriteTo_FlushesBufferedWriter(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	if _, err := lazy.WriteTo(lazy.NewSlice(ctx, []string{"a", "b"}), bw, func(s string) string {
+		return s
+	}); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if buf.String() != "ab" {
+		t.Fatalf("expected flushed output %q, got %q", "ab", buf.String())
+	}
+}
@@ -0,0 +1,76 @@
+package lazy
+
+import "context"
+
+// Zip pairs values of two streams and combines each pair.
+//
+// Input: object[A], object[B], combine(A, B) (OUT, error)
+// Output: object[OUT] (one value per pair; length of the shorter input)
+// Order: preserves input order for emitted values
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: handled via WithErrHandler → DecisionStop | DecisionIgnore
+// Buffering: output channel capacity via WithSize
+//
+// When either input closes, Zip stops reading both and calls Stop on them,
+// which halts NewSlice/New sources directly upstream. The producer of the
+// longer input otherwise stays blocked until ctx is cancelled, so tie it to
+// ctx and cancel once the result is consumed.
+func Zip[A any, B any, OUT any](ctx context.Context, a object[A], b object[B], combine func(a A, b B) (OUT, error), opts ...optionFunc) object[OUT] {
+	opt := buildOpts(opts)
+	stage := max(a.stage, b.stage) + 1
+	errs := newErrCell(a.errs, b.errs)
+	ch := make(chan OUT, opt.bufferSize(stage))
+
+	opt.group.add(errs)
+	go func() {
+		defer opt.group.done()
+		defer recover()
+		defer close(ch)
+		defer a.Stop()
+		defer b.Stop()
+		for {
+			var va A
+			var vb B
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case va, ok = <-a.ch:
+			}
+			if !ok {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case vb, ok = <-b.ch:
+			}
+			if !ok {
+				return
+			}
+
+			result, err := combine(va, vb)
+			if err != nil {
+				if decision := opt.handleError(err); decision == DecisionStop {
+					errs.set(stage, err)
+					return
+				}
+				// DecisionIgnore: drop pair and continue
+				continue
+			}
+			opt.handleSuccess()
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- result:
+			}
+		}
+	}()
+
+	return object[OUT]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
@@ -0,0 +1,85 @@
+package lazy
+
+import "context"
+
+// ZipN reads one value from each of several same-typed streams per step and
+// emits them together.
+//
+// Input: objs ...object[T]
+// Output: object[[]T] (one slice of len(objs) per step; length of the
+// shortest input)
+// Order: preserves input order; slice index i holds the value from objs[i]
+// Cancellation: guards receives and sends with select on ctx.Done()
+// Errors: none
+// Buffering: unbuffered output (objs is variadic, so there are no options)
+//
+// When any input closes, ZipN closes its output, calls Stop on every input and
+// drains what is left of them so their producers can finish. Inputs that
+// cannot be stopped directly are drained until they close or ctx is
+// cancelled. Zipping zero objects yields an already-closed stream.
+func ZipN[T any](ctx context.Context, objs ...object[T]) object[[]T] {
+	stage := 0
+	errs := newErrCell()
+	for _, obj := range objs {
+		stage = max(stage, obj.stage)
+		errs.link(obj.errs)
+	}
+	stage++
+	ch := make(chan []T)
+
+	go func() {
+		defer recover()
+		defer func() {
+			close(ch)
+			for _, obj := range objs {
+				obj.Stop()
+			}
+			for _, obj := range objs {
+				drain(ctx, obj.ch)
+			}
+		}()
+		if len(objs) == 0 {
+			return
+		}
+		for {
+			row := make([]T, len(objs))
+			for i, obj := range objs {
+				var ok bool
+				select {
+				case <-ctx.Done():
+					return
+				case row[i], ok = <-obj.ch:
+				}
+				if !ok {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- row:
+			}
+		}
+	}()
+
+	return object[[]T]{
+		ch:    ch,
+		stage: stage,
+		errs:  errs,
+	}
+}
+
+// drain discards values from c until it closes or ctx is cancelled.
+func drain[T any](ctx context.Context, c <-chan T) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-c:
+			if !ok {
+				return
+			}
+		}
+	}
+}
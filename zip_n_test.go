@@ -0,0 +1,62 @@
+package lazy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestZipN_EmitsOneSlicePerStep(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := lazy.NewSlice(ctx, []int{1, 2})
+	b := lazy.NewSlice(ctx, []int{10, 20})
+	c := lazy.NewSlice(ctx, []int{100, 200})
+
+	got, err := lazy.Collect(lazy.ZipN(ctx, a, b, c))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := [][]int{{1, 10, 100}, {2, 20, 200}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestZipN_ShortestStreamDeterminesLength(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	long := lazy.Map(ctx, lazy.New(ctx, naturals(ctx)), func(v int) (int, error) { return v, nil })
+	short := lazy.NewSlice(ctx, []int{7})
+	mid := lazy.NewSlice(ctx, []int{1, 2, 3})
+
+	got, err := lazy.Collect(lazy.ZipN(ctx, long, short, mid))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := [][]int{{0, 7, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestZipN_NoInputs(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := lazy.Collect(lazy.ZipN[int](ctx))
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no values, got %v", got)
+	}
+}
@@ -0,0 +1,77 @@
+package lazy_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/iwanhae/lazy"
+	"go.uber.org/goleak"
+)
+
+func TestZip_ShorterStreamDeterminesLength(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nums := lazy.NewSlice(ctx, []int{1, 2, 3, 4, 5})
+	names := lazy.NewSlice(ctx, []string{"a", "b", "c"})
+	pairs := lazy.Zip(ctx, nums, names, func(n int, s string) (string, error) {
+		return s + strconv.Itoa(n), nil
+	})
+
+	got, err := lazy.Collect(pairs)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []string{"a1", "b2", "c3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestZip_LongerUpstreamUnblocksViaContext(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The longer side is behind a Map, so only ctx can release its producer.
+	long := lazy.Map(ctx, lazy.New(ctx, naturals(ctx)), func(v int) (int, error) { return v, nil })
+	short := lazy.NewSlice(ctx, []int{10, 20})
+	sums := lazy.Zip(ctx, long, short, func(a, b int) (int, error) { return a + b, nil })
+
+	got, err := lazy.Collect(sums)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []int{10, 21}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}
+
+func TestZip_DefaultIgnoreError(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := lazy.NewSlice(ctx, []int{1, 2, 3})
+	b := lazy.NewSlice(ctx, []int{1, 0, 3})
+	quot := lazy.Zip(ctx, a, b, func(x, y int) (int, error) {
+		if y == 0 {
+			return 0, errors.New("divide by zero")
+		}
+		return x / y, nil
+	})
+
+	got, err := lazy.Collect(quot)
+	if err != nil {
+		t.Fatalf("collect error: %v", err)
+	}
+	want := []int{1, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected result. got=%v want=%v", got, want)
+	}
+}